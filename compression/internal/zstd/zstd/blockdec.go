@@ -12,7 +12,7 @@ import (
 	"sync"
 
 	"github.com/inovacc/toolkit/compression/internal/zstd/huff0"
-	"github.com/inovacc/toolkit/compression/internal/zstd/zstd/internal/xxhash"
+	"github.com/inovacc/toolkit/internal/xxhash"
 )
 
 type blockType uint8
@@ -10,7 +10,7 @@ import (
 	"io"
 	"sync"
 
-	"github.com/inovacc/toolkit/compression/internal/zstd/zstd/internal/xxhash"
+	"github.com/inovacc/toolkit/internal/xxhash"
 )
 
 // Decoder provides decoding of zstandard streams.
@@ -26,7 +26,7 @@ import (
 	// "github.com/DataDog/zstd"
 	// zstd "github.com/valyala/gozstd"
 
-	"github.com/inovacc/toolkit/compression/internal/zstd/zstd/internal/xxhash"
+	"github.com/inovacc/toolkit/internal/xxhash"
 )
 
 func TestNewReaderMismatch(t *testing.T) {
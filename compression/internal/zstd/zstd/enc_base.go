@@ -4,7 +4,7 @@ import (
 	"fmt"
 	"math/bits"
 
-	"github.com/inovacc/toolkit/compression/internal/zstd/zstd/internal/xxhash"
+	"github.com/inovacc/toolkit/internal/xxhash"
 )
 
 const (
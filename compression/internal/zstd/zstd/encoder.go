@@ -13,7 +13,7 @@ import (
 	rdebug "runtime/debug"
 	"sync"
 
-	"github.com/inovacc/toolkit/compression/internal/zstd/zstd/internal/xxhash"
+	"github.com/inovacc/toolkit/internal/xxhash"
 )
 
 // Encoder provides encoding to Zstandard.
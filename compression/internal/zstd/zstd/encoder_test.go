@@ -18,7 +18,7 @@ import (
 	"time"
 
 	"github.com/inovacc/toolkit/compression/internal/zstd/zip"
-	"github.com/inovacc/toolkit/compression/internal/zstd/zstd/internal/xxhash"
+	"github.com/inovacc/toolkit/internal/xxhash"
 )
 
 var testWindowSizes = []int{MinWindowSize, 1 << 16, 1 << 22, 1 << 24}
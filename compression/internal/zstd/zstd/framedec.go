@@ -10,7 +10,7 @@ import (
 	"errors"
 	"io"
 
-	"github.com/inovacc/toolkit/compression/internal/zstd/zstd/internal/xxhash"
+	"github.com/inovacc/toolkit/internal/xxhash"
 )
 
 type frameDec struct {
@@ -0,0 +1,198 @@
+package hashing
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"hash/crc64"
+	"sort"
+	"strings"
+
+	"github.com/inovacc/toolkit/internal/xxhash"
+	"golang.org/x/crypto/blake2b"
+	"golang.org/x/crypto/blake2s"
+	"golang.org/x/crypto/sha3"
+)
+
+// SHA-3 and SHAKE hash functions. SHAKE128 and SHAKE256 produce their
+// minimum full-strength outputs of 32 and 64 bytes; use NewSHAKE128 or
+// NewSHAKE256 for other lengths.
+var (
+	SHA3_224 HashFunction = sha3.New224
+	SHA3_256 HashFunction = sha3.New256
+	SHA3_384 HashFunction = sha3.New384
+	SHA3_512 HashFunction = sha3.New512
+	SHAKE128 HashFunction = func() hash.Hash { return sha3.NewShake128() }
+	SHAKE256 HashFunction = func() hash.Hash { return sha3.NewShake256() }
+)
+
+// BLAKE2 hash functions (unkeyed)
+var (
+	BLAKE2b_256 HashFunction = func() hash.Hash { return mustHash(blake2b.New256(nil)) }
+	BLAKE2b_384 HashFunction = func() hash.Hash { return mustHash(blake2b.New384(nil)) }
+	BLAKE2b_512 HashFunction = func() hash.Hash { return mustHash(blake2b.New512(nil)) }
+	BLAKE2s_256 HashFunction = func() hash.Hash { return mustHash(blake2s.New256(nil)) }
+)
+
+// Legacy hash functions. MD5 and SHA-1 are broken for collision resistance and
+// must only be used to interoperate with formats that still require them.
+var (
+	MD5  HashFunction = md5.New
+	SHA1 HashFunction = sha1.New
+)
+
+// Non-cryptographic checksums and fast hashes. They detect accidental
+// corruption but offer no protection against deliberate tampering.
+var (
+	CRC32      HashFunction = func() hash.Hash { return crc32.NewIEEE() }
+	CRC32C     HashFunction = func() hash.Hash { return crc32.New(crc32.MakeTable(crc32.Castagnoli)) }
+	CRC64_ISO  HashFunction = func() hash.Hash { return crc64.New(crc64.MakeTable(crc64.ISO)) }
+	CRC64_ECMA HashFunction = func() hash.Hash { return crc64.New(crc64.MakeTable(crc64.ECMA)) }
+	XXH64      HashFunction = func() hash.Hash { return xxhash.New() }
+)
+
+// NewSHAKE128 returns a SHAKE128 hash function producing size bytes of output
+func NewSHAKE128(size int) HashFunction {
+	return func() hash.Hash { return &shakeHash{ShakeHash: sha3.NewShake128(), size: size} }
+}
+
+// NewSHAKE256 returns a SHAKE256 hash function producing size bytes of output
+func NewSHAKE256(size int) HashFunction {
+	return func() hash.Hash { return &shakeHash{ShakeHash: sha3.NewShake256(), size: size} }
+}
+
+// shakeHash adapts an extendable-output function to a fixed-size hash.Hash
+type shakeHash struct {
+	sha3.ShakeHash
+	size int
+}
+
+func (s *shakeHash) Size() int {
+	return s.size
+}
+
+func (s *shakeHash) Sum(b []byte) []byte {
+	out := make([]byte, s.size)
+	_, _ = s.Clone().Read(out)
+	return append(b, out...)
+}
+
+// mustHash unwraps constructors that can only fail when given an invalid key
+func mustHash(h hash.Hash, err error) hash.Hash {
+	if err != nil {
+		panic(err)
+	}
+	return h
+}
+
+// Kind classifies an algorithm by the guarantees it offers
+type Kind int
+
+const (
+	// Cryptographic algorithms are suitable for integrity and security purposes
+	Cryptographic Kind = iota
+	// Legacy algorithms are cryptographically broken and kept for compatibility only
+	Legacy
+	// Checksum algorithms only detect accidental corruption
+	Checksum
+)
+
+func (k Kind) String() string {
+	switch k {
+	case Cryptographic:
+		return "cryptographic"
+	case Legacy:
+		return "legacy"
+	case Checksum:
+		return "checksum"
+	default:
+		return fmt.Sprintf("Kind(%d)", int(k))
+	}
+}
+
+// Algorithm describes a hash function registered under a canonical name
+type Algorithm struct {
+	Name string
+	Kind Kind
+	New  HashFunction
+}
+
+var algorithms = []Algorithm{
+	{Name: "sha224", Kind: Cryptographic, New: SHA224},
+	{Name: "sha256", Kind: Cryptographic, New: SHA256},
+	{Name: "sha384", Kind: Cryptographic, New: SHA384},
+	{Name: "sha512", Kind: Cryptographic, New: SHA512},
+	{Name: "sha512/224", Kind: Cryptographic, New: SHA512_224},
+	{Name: "sha512/256", Kind: Cryptographic, New: SHA512_256},
+	{Name: "sha3-224", Kind: Cryptographic, New: SHA3_224},
+	{Name: "sha3-256", Kind: Cryptographic, New: SHA3_256},
+	{Name: "sha3-384", Kind: Cryptographic, New: SHA3_384},
+	{Name: "sha3-512", Kind: Cryptographic, New: SHA3_512},
+	{Name: "shake128", Kind: Cryptographic, New: SHAKE128},
+	{Name: "shake256", Kind: Cryptographic, New: SHAKE256},
+	{Name: "blake2b-256", Kind: Cryptographic, New: BLAKE2b_256},
+	{Name: "blake2b-384", Kind: Cryptographic, New: BLAKE2b_384},
+	{Name: "blake2b-512", Kind: Cryptographic, New: BLAKE2b_512},
+	{Name: "blake2s-256", Kind: Cryptographic, New: BLAKE2s_256},
+	{Name: "md5", Kind: Legacy, New: MD5},
+	{Name: "sha1", Kind: Legacy, New: SHA1},
+	{Name: "crc32", Kind: Checksum, New: CRC32},
+	{Name: "crc32c", Kind: Checksum, New: CRC32C},
+	{Name: "crc64-iso", Kind: Checksum, New: CRC64_ISO},
+	{Name: "crc64-ecma", Kind: Checksum, New: CRC64_ECMA},
+	{Name: "xxh64", Kind: Checksum, New: XXH64},
+}
+
+// aliases maps commonly used alternative spellings to canonical names
+var aliases = map[string]string{
+	"blake2b":    "blake2b-512",
+	"blake2s":    "blake2s-256",
+	"b2":         "blake2b-512",
+	"crc32ieee":  "crc32",
+	"castagnoli": "crc32c",
+	"crc64":      "crc64-ecma",
+	"xxhash":     "xxh64",
+	"xxhash64":   "xxh64",
+}
+
+// normalizeName folds case and separators so "SHA-512/256", "sha512_256" and "sha512256" are equivalent
+func normalizeName(name string) string {
+	return strings.NewReplacer("-", "", "_", "", "/", "", " ", "").Replace(strings.ToLower(strings.TrimSpace(name)))
+}
+
+// Lookup returns the algorithm registered under name. Names are matched
+// case-insensitively and ignore '-', '_', '/' and spaces, so "SHA3-256",
+// "sha3_256" and "sha3256" all select SHA3_256.
+func Lookup(name string) (Algorithm, error) {
+	key := normalizeName(name)
+	if canonical, ok := aliases[key]; ok {
+		key = normalizeName(canonical)
+	}
+	for _, alg := range algorithms {
+		if normalizeName(alg.Name) == key {
+			return alg, nil
+		}
+	}
+	return Algorithm{}, fmt.Errorf("unknown hash algorithm: %q", name)
+}
+
+// NewHasherByName creates a Hasher for the algorithm registered under name
+func NewHasherByName(name string) (*Hasher, error) {
+	alg, err := Lookup(name)
+	if err != nil {
+		return nil, err
+	}
+	return NewHasher(alg.New), nil
+}
+
+// Algorithms returns the canonical names of all registered algorithms in sorted order
+func Algorithms() []string {
+	names := make([]string, 0, len(algorithms))
+	for _, alg := range algorithms {
+		names = append(names, alg.Name)
+	}
+	sort.Strings(names)
+	return names
+}
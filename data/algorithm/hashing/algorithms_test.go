@@ -0,0 +1,93 @@
+package hashing
+
+import (
+	"testing"
+)
+
+func TestAlgorithms(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "sha3-256", want: "36f028580bb02cc8272a9a020f4200e346e276ae664e45ee80745574e2f5ab80"},
+		{name: "sha3-512", want: "9ece086e9bac491fac5c1d1046ca11d737b92a2b2ebd93f005d7b710110c0a678288166e7fbe796883a4f2e9b3ca9f484f521d0ce464345cc1aec96779149c14"},
+		{name: "shake128", want: "d3b0aa9cd8b7255622cebc631e867d4093d6f6010191a53973c45fec9b07c774"},
+		{name: "blake2b-512", want: "a71079d42853dea26e453004338670a53814b78137ffbed07603a41d76a483aa9bc33b582f77d30a65e6f29a896c0411f38312e1d66e0bf16386c86a89bea572"},
+		{name: "blake2s-256", want: "f308fc02ce9172ad02a7d75800ecfc027109bc67987ea32aba9b8dcc7b10150e"},
+		{name: "md5", want: "098f6bcd4621d373cade4e832627b4f6"},
+		{name: "sha1", want: "a94a8fe5ccb19ba61c4c0873d391e987982fbbd3"},
+		{name: "crc32", want: "d87f7e0c"},
+		{name: "crc32c", want: "86a072c0"},
+		{name: "xxh64", want: "4fdcca5ddb678139"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hasher, err := NewHasherByName(tt.name)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := hasher.HashString("test"); got != tt.want {
+				t.Errorf("%s(test) = %s, want %s", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAlgorithmsRegistered(t *testing.T) {
+	for _, name := range Algorithms() {
+		alg, err := Lookup(name)
+		if err != nil {
+			t.Errorf("Lookup(%q) failed: %v", name, err)
+			continue
+		}
+
+		if size := NewHasher(alg.New).GetSize(); size <= 0 {
+			t.Errorf("%s reports size %d", name, size)
+		}
+	}
+}
+
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+		kind  Kind
+	}{
+		{input: "SHA-256", want: "sha256", kind: Cryptographic},
+		{input: "sha512_256", want: "sha512/256", kind: Cryptographic},
+		{input: "SHA3_384", want: "sha3-384", kind: Cryptographic},
+		{input: "blake2b", want: "blake2b-512", kind: Cryptographic},
+		{input: "SHA1", want: "sha1", kind: Legacy},
+		{input: "xxHash64", want: "xxh64", kind: Checksum},
+	}
+
+	for _, tt := range tests {
+		alg, err := Lookup(tt.input)
+		if err != nil {
+			t.Errorf("Lookup(%q) failed: %v", tt.input, err)
+			continue
+		}
+
+		if alg.Name != tt.want || alg.Kind != tt.kind {
+			t.Errorf("Lookup(%q) = %s (%s), want %s (%s)", tt.input, alg.Name, alg.Kind, tt.want, tt.kind)
+		}
+	}
+
+	if _, err := Lookup("whirlpool"); err == nil {
+		t.Error("expected error for unknown algorithm")
+	}
+}
+
+func TestNewSHAKE(t *testing.T) {
+	hasher := NewHasher(NewSHAKE256(16))
+	if size := hasher.GetSize(); size != 16 {
+		t.Fatalf("GetSize = %d, want 16", size)
+	}
+
+	full := NewHasher(SHAKE256).HashString("test")
+	if got := hasher.HashString("test"); got != full[:32] {
+		t.Errorf("truncated SHAKE256 = %s, want prefix of %s", got, full)
+	}
+}
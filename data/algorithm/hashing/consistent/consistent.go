@@ -0,0 +1,75 @@
+// Package consistent maps keys to nodes so that membership changes move as few
+// keys as possible. It provides a consistent-hash ring with weighted virtual
+// nodes, rendezvous (highest random weight) hashing and jump hashing.
+package consistent
+
+import (
+	"encoding/binary"
+
+	"github.com/inovacc/toolkit/data/algorithm/hashing"
+	"github.com/inovacc/toolkit/internal/xxhash"
+)
+
+// DefaultReplicas is the number of virtual nodes placed on the ring per unit of weight
+const DefaultReplicas = 160
+
+// Hash64 maps arbitrary data to a 64-bit value
+type Hash64 func(data []byte) uint64
+
+// XXH64 is the default Hash64; it is fast and well distributed but not cryptographic
+var XXH64 Hash64 = xxhash.Sum64
+
+// FromHashFunction adapts any hashing.HashFunction to a Hash64 by reading the
+// first 8 bytes of its digest. Shorter digests, such as CRC32, are zero padded.
+func FromHashFunction(hf hashing.HashFunction) Hash64 {
+	hasher := hashing.NewHasher(hf)
+	return func(data []byte) uint64 {
+		var buf [8]byte
+		copy(buf[:], hasher.Sum(data))
+		return binary.BigEndian.Uint64(buf[:])
+	}
+}
+
+// OptsFn configures a Ring or Rendezvous
+type OptsFn func(opts *Config)
+
+// Config holds the options shared by Ring and Rendezvous
+type Config struct {
+	hash     Hash64
+	replicas int
+}
+
+// NewConfig creates a Config using XXH64 and DefaultReplicas
+func NewConfig(o ...OptsFn) *Config {
+	cfg := &Config{
+		hash:     XXH64,
+		replicas: DefaultReplicas,
+	}
+	for _, opts := range o {
+		opts(cfg)
+	}
+	return cfg
+}
+
+// WithHash selects the hash used to place nodes and keys
+func WithHash(h Hash64) OptsFn {
+	return func(opts *Config) {
+		if h != nil {
+			opts.hash = h
+		}
+	}
+}
+
+// WithHashFunction selects a hashing.HashFunction to place nodes and keys
+func WithHashFunction(hf hashing.HashFunction) OptsFn {
+	return WithHash(FromHashFunction(hf))
+}
+
+// WithReplicas sets the number of virtual nodes per unit of weight on a Ring
+func WithReplicas(n int) OptsFn {
+	return func(opts *Config) {
+		if n > 0 {
+			opts.replicas = n
+		}
+	}
+}
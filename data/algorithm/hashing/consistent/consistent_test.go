@@ -0,0 +1,174 @@
+package consistent
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/inovacc/toolkit/data/algorithm/hashing"
+)
+
+func keys(n int) []string {
+	out := make([]string, n)
+	for i := range out {
+		out[i] = fmt.Sprintf("key-%d", i)
+	}
+	return out
+}
+
+type router interface {
+	Get(key string) (string, bool)
+}
+
+func assign(r router, ks []string) map[string]string {
+	owners := make(map[string]string, len(ks))
+	for _, k := range ks {
+		owners[k], _ = r.Get(k)
+	}
+	return owners
+}
+
+func TestRingMinimalMovement(t *testing.T) {
+	ring := NewRing()
+	ring.Add("node-a", "node-b", "node-c", "node-d")
+
+	ks := keys(10000)
+	before := assign(ring, ks)
+
+	ring.Add("node-e")
+	after := assign(ring, ks)
+
+	for _, k := range ks {
+		if before[k] != after[k] && after[k] != "node-e" {
+			t.Fatalf("key %s moved from %s to %s, not to the new node", k, before[k], after[k])
+		}
+	}
+
+	ring.Remove("node-e")
+	restored := assign(ring, ks)
+	for _, k := range ks {
+		if before[k] != restored[k] {
+			t.Fatalf("key %s did not return to %s after removal", k, before[k])
+		}
+	}
+}
+
+func TestRingWeights(t *testing.T) {
+	ring := NewRing(WithReplicas(200))
+	ring.Add("small")
+	ring.AddWeighted("large", 3)
+
+	counts := map[string]int{}
+	for _, owner := range assign(ring, keys(20000)) {
+		counts[owner]++
+	}
+
+	ratio := float64(counts["large"]) / float64(counts["small"])
+	if ratio < 2.4 || ratio > 3.6 {
+		t.Errorf("weighted ratio = %.2f, want about 3 (%v)", ratio, counts)
+	}
+}
+
+func TestRingGetN(t *testing.T) {
+	ring := NewRing(WithHashFunction(hashing.SHA256))
+	if _, ok := ring.Get("key"); ok {
+		t.Error("empty ring returned a node")
+	}
+
+	ring.Add("a", "b", "c")
+	nodes := ring.GetN("key", 5)
+	if len(nodes) != 3 {
+		t.Fatalf("GetN returned %v, want 3 distinct nodes", nodes)
+	}
+
+	owner, _ := ring.Get("key")
+	if nodes[0] != owner {
+		t.Errorf("GetN starts with %s, want owner %s", nodes[0], owner)
+	}
+
+	seen := map[string]bool{}
+	for _, n := range nodes {
+		if seen[n] {
+			t.Errorf("GetN returned duplicate node %s", n)
+		}
+		seen[n] = true
+	}
+}
+
+func TestRendezvousMinimalMovement(t *testing.T) {
+	r := NewRendezvous()
+	r.Add("node-a", "node-b", "node-c")
+
+	ks := keys(5000)
+	before := assign(r, ks)
+
+	r.Remove("node-b")
+	after := assign(r, ks)
+
+	for _, k := range ks {
+		if before[k] != "node-b" && before[k] != after[k] {
+			t.Fatalf("key %s moved from %s to %s although its node stayed", k, before[k], after[k])
+		}
+	}
+}
+
+func TestRendezvousWeights(t *testing.T) {
+	r := NewRendezvous()
+	r.AddWeighted("small", 1)
+	r.AddWeighted("large", 4)
+
+	counts := map[string]int{}
+	for _, owner := range assign(r, keys(20000)) {
+		counts[owner]++
+	}
+
+	ratio := float64(counts["large"]) / float64(counts["small"])
+	if ratio < 3.4 || ratio > 4.6 {
+		t.Errorf("weighted ratio = %.2f, want about 4 (%v)", ratio, counts)
+	}
+}
+
+func TestJumpHash(t *testing.T) {
+	if got := JumpHash(42, 0); got != -1 {
+		t.Errorf("JumpHash with no buckets = %d, want -1", got)
+	}
+
+	ks := keys(10000)
+	for _, k := range ks {
+		prev := JumpHashString(k, 10, nil)
+		next := JumpHashString(k, 11, nil)
+		if prev < 0 || prev >= 10 {
+			t.Fatalf("bucket %d out of range", prev)
+		}
+		if prev != next && next != 10 {
+			t.Fatalf("key %s moved from %d to %d instead of the new bucket", k, prev, next)
+		}
+	}
+}
+
+func TestConcurrentMembership(t *testing.T) {
+	ring := NewRing(WithReplicas(20))
+	r := NewRendezvous()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			node := fmt.Sprintf("node-%d", i)
+			for j := 0; j < 50; j++ {
+				ring.Add(node)
+				r.Add(node)
+				ring.Get(node)
+				r.Get(node)
+				ring.Remove(node)
+				r.Remove(node)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if ring.Len() != 0 || len(r.Nodes()) != 0 {
+		t.Errorf("expected no members, got ring=%v rendezvous=%v", ring.Nodes(), r.Nodes())
+	}
+}
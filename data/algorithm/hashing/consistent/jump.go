@@ -0,0 +1,27 @@
+package consistent
+
+// JumpHash maps key to a bucket in [0, buckets) using the jump consistent hash
+// of Lamping and Veach. Growing from n to n+1 buckets moves only 1/(n+1) of the
+// keys, and all of them into the new bucket. Buckets are numbered, so it suits
+// shards and partitions that are only ever appended; it returns -1 if buckets < 1.
+func JumpHash(key uint64, buckets int) int {
+	if buckets < 1 {
+		return -1
+	}
+
+	var b, j int64 = -1, 0
+	for j < int64(buckets) {
+		b = j
+		key = key*2862933555777941757 + 1
+		j = int64(float64(b+1) * (float64(int64(1)<<31) / float64((key>>33)+1)))
+	}
+	return int(b)
+}
+
+// JumpHashString hashes key with h, or XXH64 when h is nil, and maps it to a bucket with JumpHash
+func JumpHashString(key string, buckets int, h Hash64) int {
+	if h == nil {
+		h = XXH64
+	}
+	return JumpHash(h([]byte(key)), buckets)
+}
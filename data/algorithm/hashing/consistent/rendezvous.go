@@ -0,0 +1,119 @@
+package consistent
+
+import (
+	"math"
+	"sort"
+	"sync"
+)
+
+// Rendezvous implements weighted rendezvous, or highest random weight, hashing.
+// Every node scores every key and the highest score wins, so it needs no virtual
+// nodes and removing a node only moves the keys that node owned. Lookups cost
+// O(nodes), which makes it a good fit for small and medium clusters.
+// It is safe for concurrent use.
+type Rendezvous struct {
+	mu      sync.RWMutex
+	cfg     *Config
+	weights map[string]float64
+}
+
+// NewRendezvous creates an empty Rendezvous
+func NewRendezvous(o ...OptsFn) *Rendezvous {
+	return &Rendezvous{
+		cfg:     NewConfig(o...),
+		weights: make(map[string]float64),
+	}
+}
+
+// Add registers nodes with weight 1. Nodes already present keep their weight.
+func (r *Rendezvous) Add(nodes ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, node := range nodes {
+		if _, ok := r.weights[node]; !ok {
+			r.weights[node] = 1
+		}
+	}
+}
+
+// AddWeighted registers node, or updates its weight. A weight of 0 or less removes the node.
+func (r *Rendezvous) AddWeighted(node string, weight float64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if weight <= 0 {
+		delete(r.weights, node)
+		return
+	}
+	r.weights[node] = weight
+}
+
+// Remove unregisters nodes
+func (r *Rendezvous) Remove(nodes ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, node := range nodes {
+		delete(r.weights, node)
+	}
+}
+
+// Get returns the node with the highest score for key, or false if there are no nodes
+func (r *Rendezvous) Get(key string) (string, bool) {
+	nodes := r.GetN(key, 1)
+	if len(nodes) == 0 {
+		return "", false
+	}
+	return nodes[0], true
+}
+
+// GetN returns up to n nodes for key ordered by descending score
+func (r *Rendezvous) GetN(key string, n int) []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if n <= 0 || len(r.weights) == 0 {
+		return nil
+	}
+
+	type scored struct {
+		node  string
+		score float64
+	}
+	scores := make([]scored, 0, len(r.weights))
+	for node, weight := range r.weights {
+		scores = append(scores, scored{node: node, score: r.score(key, node, weight)})
+	}
+	sort.Slice(scores, func(i, j int) bool {
+		if scores[i].score != scores[j].score {
+			return scores[i].score > scores[j].score
+		}
+		return scores[i].node < scores[j].node
+	})
+
+	if n > len(scores) {
+		n = len(scores)
+	}
+	nodes := make([]string, n)
+	for i := range nodes {
+		nodes[i] = scores[i].node
+	}
+	return nodes
+}
+
+// Nodes returns the registered nodes in sorted order
+func (r *Rendezvous) Nodes() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return sortedKeys(r.weights)
+}
+
+// score uses the logarithmic method, -weight/ln(u) with u uniform in (0, 1),
+// which assigns keys to nodes in proportion to their weights
+func (r *Rendezvous) score(key, node string, weight float64) float64 {
+	h := r.cfg.hash([]byte(node + "\x00" + key))
+	u := (float64(h>>11) + 0.5) / (1 << 53)
+	return -weight / math.Log(u)
+}
@@ -0,0 +1,167 @@
+package consistent
+
+import (
+	"sort"
+	"strconv"
+	"sync"
+)
+
+type point struct {
+	hash uint64
+	node string
+}
+
+// Ring is a consistent-hash ring with weighted virtual nodes.
+// It is safe for concurrent use; lookups may run while members are added or removed.
+type Ring struct {
+	mu      sync.RWMutex
+	cfg     *Config
+	weights map[string]int
+	points  []point
+}
+
+// NewRing creates an empty Ring
+func NewRing(o ...OptsFn) *Ring {
+	return &Ring{
+		cfg:     NewConfig(o...),
+		weights: make(map[string]int),
+	}
+}
+
+// Add places nodes on the ring with weight 1. Nodes already present keep their weight.
+func (r *Ring) Add(nodes ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	changed := false
+	for _, node := range nodes {
+		if _, ok := r.weights[node]; !ok {
+			r.weights[node] = 1
+			changed = true
+		}
+	}
+	if changed {
+		r.rebuild()
+	}
+}
+
+// AddWeighted places node on the ring, or updates its weight. A node with weight 2
+// receives twice as many virtual nodes, and therefore about twice as many keys,
+// as a node with weight 1. A weight below 1 removes the node.
+func (r *Ring) AddWeighted(node string, weight int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if weight < 1 {
+		delete(r.weights, node)
+	} else {
+		r.weights[node] = weight
+	}
+	r.rebuild()
+}
+
+// Remove takes nodes off the ring; only the keys they owned move elsewhere
+func (r *Ring) Remove(nodes ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, node := range nodes {
+		delete(r.weights, node)
+	}
+	r.rebuild()
+}
+
+// Get returns the node that owns key, or false if the ring is empty
+func (r *Ring) Get(key string) (string, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if len(r.points) == 0 {
+		return "", false
+	}
+	return r.points[r.search(key)].node, true
+}
+
+// GetN returns up to n distinct nodes for key, walking the ring clockwise from the owner.
+// It is typically used to choose replicas.
+func (r *Ring) GetN(key string, n int) []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if len(r.points) == 0 || n <= 0 {
+		return nil
+	}
+	if n > len(r.weights) {
+		n = len(r.weights)
+	}
+
+	nodes := make([]string, 0, n)
+	seen := make(map[string]bool, n)
+	for i, start := 0, r.search(key); len(nodes) < n && i < len(r.points); i++ {
+		p := r.points[(start+i)%len(r.points)]
+		if !seen[p.node] {
+			seen[p.node] = true
+			nodes = append(nodes, p.node)
+		}
+	}
+	return nodes
+}
+
+// Nodes returns the members of the ring in sorted order
+func (r *Ring) Nodes() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return sortedKeys(r.weights)
+}
+
+// Len returns the number of members on the ring
+func (r *Ring) Len() int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return len(r.weights)
+}
+
+// search returns the index of the first point at or after the hash of key, wrapping around
+func (r *Ring) search(key string) int {
+	h := r.cfg.hash([]byte(key))
+	i := sort.Search(len(r.points), func(i int) bool {
+		return r.points[i].hash >= h
+	})
+	if i == len(r.points) {
+		i = 0
+	}
+	return i
+}
+
+// rebuild recomputes the sorted virtual nodes; the caller must hold the write lock
+func (r *Ring) rebuild() {
+	points := make([]point, 0, len(r.points))
+	for node, weight := range r.weights {
+		for i := 0; i < weight*r.cfg.replicas; i++ {
+			points = append(points, point{
+				hash: r.cfg.hash([]byte(node + "#" + strconv.Itoa(i))),
+				node: node,
+			})
+		}
+	}
+
+	// Break hash ties by node name so the layout never depends on map iteration order
+	sort.Slice(points, func(i, j int) bool {
+		if points[i].hash != points[j].hash {
+			return points[i].hash < points[j].hash
+		}
+		return points[i].node < points[j].node
+	})
+	r.points = points
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
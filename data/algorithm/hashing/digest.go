@@ -0,0 +1,54 @@
+package hashing
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+)
+
+// Digest is the raw output of a hash function
+type Digest []byte
+
+// Bytes returns the raw digest
+func (d Digest) Bytes() []byte {
+	return d
+}
+
+// Hex returns the lowercase hexadecimal encoding of the digest
+func (d Digest) Hex() string {
+	return hex.EncodeToString(d)
+}
+
+// Base64 returns the standard padded base64 encoding of the digest
+func (d Digest) Base64() string {
+	return base64.StdEncoding.EncodeToString(d)
+}
+
+// Base64URL returns the unpadded URL-safe base64 encoding of the digest
+func (d Digest) Base64URL() string {
+	return base64.RawURLEncoding.EncodeToString(d)
+}
+
+// String returns the hexadecimal encoding of the digest
+func (d Digest) String() string {
+	return d.Hex()
+}
+
+// Equal compares two digests in constant time
+func (d Digest) Equal(other Digest) bool {
+	return Equal(d, other)
+}
+
+// ParseHex decodes a hexadecimal digest
+func ParseHex(s string) (Digest, error) {
+	return hex.DecodeString(strings.TrimSpace(s))
+}
+
+// ParseBase64 decodes a base64 digest in either the standard or URL-safe alphabet, padded or not
+func ParseBase64(s string) (Digest, error) {
+	s = strings.TrimRight(strings.TrimSpace(s), "=")
+	if strings.ContainsAny(s, "-_") {
+		return base64.RawURLEncoding.DecodeString(s)
+	}
+	return base64.RawStdEncoding.DecodeString(s)
+}
@@ -0,0 +1,135 @@
+package hashing
+
+import (
+	"bufio"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/afero"
+)
+
+// DirOptsFn configures the behavior of HashDir
+type DirOptsFn func(opts *DirConfig)
+
+// DirConfig holds the options used by HashDir
+type DirConfig struct {
+	exclude []string
+}
+
+// NewDirConfig creates a DirConfig with the given options applied
+func NewDirConfig(o ...DirOptsFn) *DirConfig {
+	cfg := &DirConfig{}
+	for _, opts := range o {
+		opts(cfg)
+	}
+	return cfg
+}
+
+// WithExclude skips every file or directory whose name starts with one of the given patterns,
+// mirroring tree.WithExclude
+func WithExclude(data ...string) DirOptsFn {
+	return func(opts *DirConfig) {
+		opts.exclude = append(opts.exclude, data...)
+	}
+}
+
+// HashDir returns a deterministic Merkle-style hexadecimal digest of the directory tree at root.
+//
+// Every regular file contributes the hash of its contents, every directory contributes the
+// hash of its sorted children, and each entry is bound to its slash-separated path relative
+// to root and its file mode. Renaming, moving, re-permissioning or editing any entry changes
+// the result, while the order in which the filesystem lists entries does not.
+func (h *Hasher) HashDir(fsys afero.Fs, root string, o ...DirOptsFn) (string, error) {
+	if fsys == nil {
+		return "", fmt.Errorf("nil filesystem")
+	}
+
+	info, err := fsys.Stat(root)
+	if err != nil {
+		return "", err
+	}
+	if !info.IsDir() {
+		return "", fmt.Errorf("%s is not a directory", root)
+	}
+
+	sum, err := h.sumDir(fsys, root, "", NewDirConfig(o...))
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(sum), nil
+}
+
+// sumDir hashes the directory root/rel by combining the digests of its children
+func (h *Hasher) sumDir(fsys afero.Fs, root, rel string, cfg *DirConfig) ([]byte, error) {
+	entries, err := afero.ReadDir(fsys, filepath.Join(root, filepath.FromSlash(rel)))
+	if err != nil {
+		return nil, err
+	}
+
+	nh := h.newHash()
+	for _, entry := range entries {
+		if cfg.isExcluded(entry.Name()) {
+			continue
+		}
+
+		childRel := path.Join(rel, entry.Name())
+
+		var (
+			kind   byte
+			digest []byte
+		)
+		switch mode := entry.Mode(); {
+		case mode.IsDir():
+			kind = 'd'
+			digest, err = h.sumDir(fsys, root, childRel, cfg)
+		case mode.IsRegular():
+			kind = 'f'
+			digest, err = h.sumFile(fsys, filepath.Join(root, filepath.FromSlash(childRel)))
+		case mode&fs.ModeSymlink != 0:
+			reader, ok := fsys.(afero.LinkReader)
+			if !ok {
+				continue // Symlinks cannot be resolved on this filesystem
+			}
+			var target string
+			target, err = reader.ReadlinkIfPossible(filepath.Join(root, filepath.FromSlash(childRel)))
+			kind = 'l'
+			digest = []byte(filepath.ToSlash(target))
+		default:
+			continue // Devices, sockets and pipes have no stable content
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		// Each record is self-delimiting so distinct trees can never serialize identically
+		_, _ = fmt.Fprintf(nh, "%c %o %d:%s %d:", kind, entry.Mode(), len(childRel), childRel, len(digest))
+		nh.Write(digest)
+	}
+
+	return nh.Sum(nil), nil
+}
+
+// sumFile streams a single file from fsys into a fresh hash
+func (h *Hasher) sumFile(fsys afero.Fs, name string) ([]byte, error) {
+	file, err := fsys.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return h.sumReader(bufio.NewReaderSize(file, bufferSize))
+}
+
+// isExcluded checks if the given name matches any of the configured exclude patterns
+func (c *DirConfig) isExcluded(name string) bool {
+	for _, pattern := range c.exclude {
+		if strings.HasPrefix(name, pattern) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,148 @@
+package hashing
+
+import (
+	"testing"
+
+	"github.com/spf13/afero"
+)
+
+func newTestFs(t *testing.T) afero.Fs {
+	t.Helper()
+
+	fs := afero.NewMemMapFs()
+	files := map[string]string{
+		"root/a.txt":                 "alpha",
+		"root/sub/b.txt":             "bravo",
+		"root/sub/deep/c.txt":        "charlie",
+		"root/node_modules/pkg.js":   "ignored",
+		"root/.git/HEAD":             "ref: refs/heads/main",
+		"root/sub/node_modules/x.js": "ignored too",
+	}
+	for name, content := range files {
+		if err := afero.WriteFile(fs, name, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return fs
+}
+
+func TestHashDirDeterministic(t *testing.T) {
+	hasher := NewHasher(SHA256)
+
+	first, err := hasher.HashDir(newTestFs(t), "root")
+	if err != nil {
+		t.Fatalf("HashDir failed: %v", err)
+	}
+
+	second, err := hasher.HashDir(newTestFs(t), "root")
+	if err != nil {
+		t.Fatalf("HashDir failed: %v", err)
+	}
+
+	if first != second {
+		t.Errorf("HashDir is not deterministic: %s != %s", first, second)
+	}
+}
+
+func TestHashDirDetectsChanges(t *testing.T) {
+	hasher := NewHasher(SHA256)
+
+	base, err := hasher.HashDir(newTestFs(t), "root")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		mutate func(fs afero.Fs) error
+	}{
+		{
+			name: "content",
+			mutate: func(fs afero.Fs) error {
+				return afero.WriteFile(fs, "root/sub/b.txt", []byte("bravo!"), 0644)
+			},
+		},
+		{
+			name: "rename",
+			mutate: func(fs afero.Fs) error {
+				return fs.Rename("root/a.txt", "root/z.txt")
+			},
+		},
+		{
+			name: "move",
+			mutate: func(fs afero.Fs) error {
+				return fs.Rename("root/sub/deep/c.txt", "root/sub/c.txt")
+			},
+		},
+		{
+			name: "mode",
+			mutate: func(fs afero.Fs) error {
+				return fs.Chmod("root/a.txt", 0755)
+			},
+		},
+		{
+			name: "new file",
+			mutate: func(fs afero.Fs) error {
+				return afero.WriteFile(fs, "root/sub/new.txt", nil, 0644)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := newTestFs(t)
+			if err := tt.mutate(fs); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := hasher.HashDir(fs, "root")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got == base {
+				t.Errorf("expected digest to change after %s", tt.name)
+			}
+		})
+	}
+}
+
+func TestHashDirExclude(t *testing.T) {
+	hasher := NewHasher(SHA256)
+
+	fs := newTestFs(t)
+	base, err := hasher.HashDir(fs, "root", WithExclude("node_modules", ".git"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := afero.WriteFile(fs, "root/node_modules/pkg.js", []byte("changed"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := hasher.HashDir(fs, "root", WithExclude("node_modules", ".git"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got != base {
+		t.Error("changes in excluded entries must not affect the digest")
+	}
+}
+
+func TestHashDirErrors(t *testing.T) {
+	hasher := NewHasher(SHA256)
+	fs := newTestFs(t)
+
+	if _, err := hasher.HashDir(nil, "root"); err == nil {
+		t.Error("expected error for nil filesystem")
+	}
+
+	if _, err := hasher.HashDir(fs, "missing"); err == nil {
+		t.Error("expected error for missing root")
+	}
+
+	if _, err := hasher.HashDir(fs, "root/a.txt"); err == nil {
+		t.Error("expected error for file root")
+	}
+}
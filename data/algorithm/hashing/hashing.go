@@ -1,13 +1,19 @@
 package hashing
 
 import (
-	"bytes"
+	"bufio"
 	"crypto/sha256"
 	"crypto/sha512"
+	"crypto/subtle"
 	"encoding/hex"
 	"hash"
+	"io"
+	"os"
 )
 
+// bufferSize is the read buffer used when streaming files and readers into a hash
+const bufferSize = 64 * 1024
+
 // HashFunction represents a function that creates a new hash.Hash
 type HashFunction func() hash.Hash
 
@@ -45,6 +51,53 @@ func (h *Hasher) HashBytes(data []byte) string {
 	return hex.EncodeToString(nh.Sum(nil))
 }
 
+// Sum returns the raw digest of the given byte slice
+func (h *Hasher) Sum(data []byte) Digest {
+	nh := h.newHash()
+	nh.Write(data)
+	return nh.Sum(nil)
+}
+
+// SumReader returns the raw digest of everything read from r until EOF
+func (h *Hasher) SumReader(r io.Reader) (Digest, error) {
+	return h.sumReader(r)
+}
+
+// HashBase64 returns the standard base64 encoding of the hash of the given byte slice
+func (h *Hasher) HashBase64(data []byte) string {
+	return h.Sum(data).Base64()
+}
+
+// HashReader returns the hexadecimal hash of everything read from r until EOF.
+// The data is streamed through the hash, so arbitrarily large inputs are supported.
+func (h *Hasher) HashReader(r io.Reader) (string, error) {
+	sum, err := h.sumReader(r)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(sum), nil
+}
+
+// HashFile returns the hexadecimal hash of the file at path using buffered I/O
+func (h *Hasher) HashFile(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	return h.HashReader(bufio.NewReaderSize(file, bufferSize))
+}
+
+// sumReader streams r into a fresh hash and returns the raw digest
+func (h *Hasher) sumReader(r io.Reader) ([]byte, error) {
+	nh := h.newHash()
+	if _, err := io.CopyBuffer(nh, r, make([]byte, bufferSize)); err != nil {
+		return nil, err
+	}
+	return nh.Sum(nil), nil
+}
+
 // GetSize returns the size of the hash output in bytes
 func (h *Hasher) GetSize() int {
 	return h.newHash().Size()
@@ -60,12 +113,42 @@ func (h *Hasher) Reset() hash.Hash {
 	return h.newHash()
 }
 
-// CompareString compares two hashes and returns true if they are equal
+// CompareString compares two hashes in constant time and returns true if they are equal
 func (h *Hasher) CompareString(a, b string) bool {
 	return h.CompareBytes([]byte(a), []byte(b))
 }
 
-// CompareBytes compares two hashes and returns true if they are equal
+// CompareBytes compares two hashes in constant time and returns true if they are equal
 func (h *Hasher) CompareBytes(a, b []byte) bool {
-	return bytes.Equal(a, b)
+	return Equal(a, b)
+}
+
+// Verify hashes data and compares the result with the expected raw digest in constant time
+func (h *Hasher) Verify(data []byte, expected []byte) bool {
+	return Equal(h.Sum(data), expected)
+}
+
+// VerifyHex hashes data and compares the result with the expected hexadecimal digest in constant time.
+// Upper and lower case hexadecimal are both accepted.
+func (h *Hasher) VerifyHex(data []byte, expected string) bool {
+	digest, err := ParseHex(expected)
+	if err != nil {
+		return false
+	}
+	return h.Verify(data, digest)
+}
+
+// VerifyBase64 hashes data and compares the result with the expected base64 digest in constant time.
+// Both the standard and URL-safe alphabets are accepted, with or without padding.
+func (h *Hasher) VerifyBase64(data []byte, expected string) bool {
+	digest, err := ParseBase64(expected)
+	if err != nil {
+		return false
+	}
+	return h.Verify(data, digest)
+}
+
+// Equal reports whether a and b are equal without leaking timing information about their contents
+func Equal(a, b []byte) bool {
+	return subtle.ConstantTimeCompare(a, b) == 1
 }
@@ -1,6 +1,10 @@
 package hashing
 
 import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -54,3 +58,39 @@ func TestHasher(t *testing.T) {
 		})
 	}
 }
+
+func TestHasherHashReader(t *testing.T) {
+	hasher := NewHasher(SHA256)
+
+	got, err := hasher.HashReader(strings.NewReader("test"))
+	if err != nil {
+		t.Fatalf("HashReader failed: %v", err)
+	}
+
+	if want := hasher.HashString("test"); got != want {
+		t.Errorf("HashReader = %s, want %s", got, want)
+	}
+}
+
+func TestHasherHashFile(t *testing.T) {
+	hasher := NewHasher(SHA256)
+	content := bytes.Repeat([]byte("toolkit"), 100_000)
+
+	path := filepath.Join(t.TempDir(), "data.bin")
+	if err := os.WriteFile(path, content, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := hasher.HashFile(path)
+	if err != nil {
+		t.Fatalf("HashFile failed: %v", err)
+	}
+
+	if want := hasher.HashBytes(content); got != want {
+		t.Errorf("HashFile = %s, want %s", got, want)
+	}
+
+	if _, err := hasher.HashFile(filepath.Join(t.TempDir(), "missing")); err == nil {
+		t.Error("expected error for missing file")
+	}
+}
@@ -0,0 +1,39 @@
+package hashing
+
+import (
+	"crypto/hmac"
+	"errors"
+	"hash"
+	"io"
+
+	"golang.org/x/crypto/hkdf"
+)
+
+// NewHMAC creates a Hasher that computes HMAC digests keyed with key over the given hash function.
+// Every Hasher method, including the streaming and verification helpers, works on the keyed digest.
+func NewHMAC(hf HashFunction, key []byte) *Hasher {
+	k := make([]byte, len(key))
+	copy(k, key)
+	return &Hasher{
+		newHash: func() hash.Hash {
+			return hmac.New(hf, k)
+		},
+	}
+}
+
+// DeriveKey derives a key of the given length from secret using HKDF (RFC 5869).
+// The salt is optional; info binds the key to a specific context such as "webhook-signing".
+func DeriveKey(hf HashFunction, secret, salt, info []byte, length int) ([]byte, error) {
+	if length <= 0 {
+		return nil, errors.New("key length must be positive")
+	}
+	if length > 255*hf().Size() {
+		return nil, errors.New("key length exceeds HKDF maximum")
+	}
+
+	key := make([]byte, length)
+	if _, err := io.ReadFull(hkdf.New(hf, secret, salt, info), key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
@@ -0,0 +1,85 @@
+package hashing
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+func TestNewHMAC(t *testing.T) {
+	// RFC 4231, test case 2
+	hasher := NewHMAC(SHA256, []byte("Jefe"))
+	data := []byte("what do ya want for nothing?")
+	want := "5bdcc146bf60754e6a042426089575c75a003f089d2739839dec58b964ec3843"
+
+	if got := hasher.HashBytes(data); got != want {
+		t.Errorf("HashBytes = %s, want %s", got, want)
+	}
+
+	if !hasher.VerifyHex(data, want) {
+		t.Error("VerifyHex rejected a valid digest")
+	}
+
+	if !hasher.VerifyBase64(data, hasher.HashBase64(data)) {
+		t.Error("VerifyBase64 rejected a valid digest")
+	}
+
+	if !hasher.VerifyBase64(data, hasher.Sum(data).Base64URL()) {
+		t.Error("VerifyBase64 rejected a valid URL-safe digest")
+	}
+
+	if hasher.VerifyHex([]byte("tampered"), want) {
+		t.Error("VerifyHex accepted a digest for different data")
+	}
+
+	if hasher.VerifyHex(data, "not hex") {
+		t.Error("VerifyHex accepted a malformed digest")
+	}
+
+	other := NewHMAC(SHA256, []byte("other"))
+	if other.Verify(data, hasher.Sum(data)) {
+		t.Error("digest verified under a different key")
+	}
+}
+
+func TestCompareBytes(t *testing.T) {
+	hasher := NewHasher(SHA256)
+	a := hasher.Sum([]byte("a"))
+
+	if !hasher.CompareBytes(a, hasher.Sum([]byte("a"))) {
+		t.Error("equal digests compared unequal")
+	}
+
+	if hasher.CompareBytes(a, hasher.Sum([]byte("b"))) {
+		t.Error("different digests compared equal")
+	}
+
+	if hasher.CompareBytes(a, a[:10]) {
+		t.Error("digests of different length compared equal")
+	}
+}
+
+func TestDeriveKey(t *testing.T) {
+	// RFC 5869, test case 1
+	secret, _ := hex.DecodeString("0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b")
+	salt, _ := hex.DecodeString("000102030405060708090a0b0c")
+	info, _ := hex.DecodeString("f0f1f2f3f4f5f6f7f8f9")
+	want, _ := hex.DecodeString("3cb25f25faacd57a90434f64d0362f2a2d2d0a90cf1a5a4c5db02d56ecc4c5bf34007208d5b887185865")
+
+	got, err := DeriveKey(SHA256, secret, salt, info, 42)
+	if err != nil {
+		t.Fatalf("DeriveKey failed: %v", err)
+	}
+
+	if !bytes.Equal(got, want) {
+		t.Errorf("DeriveKey = %x, want %x", got, want)
+	}
+
+	if _, err := DeriveKey(SHA256, secret, salt, info, 0); err == nil {
+		t.Error("expected error for zero length")
+	}
+
+	if _, err := DeriveKey(SHA256, secret, salt, info, 255*32+1); err == nil {
+		t.Error("expected error for oversized length")
+	}
+}
@@ -0,0 +1,398 @@
+// Package manifest generates and verifies checksum manifests compatible with
+// sha256sum, b2sum and their BSD-style --tag output, and produces Subresource
+// Integrity strings.
+package manifest
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/inovacc/toolkit/data/algorithm/hashing"
+	"github.com/spf13/afero"
+)
+
+// Format selects the line layout used when encoding a manifest
+type Format int
+
+const (
+	// GNU is the coreutils layout: "<hex>  <path>"
+	GNU Format = iota
+	// BSD is the tagged layout: "SHA256 (<path>) = <hex>"
+	BSD
+	// SRI is the Subresource Integrity layout: "sha384-<base64>  <path>"
+	SRI
+)
+
+// Entry is the digest of a single file in a manifest
+type Entry struct {
+	Path      string
+	Algorithm string
+	Digest    hashing.Digest
+}
+
+// Manifest is an ordered list of file digests
+type Manifest struct {
+	Entries []Entry
+}
+
+// OptsFn configures Generate, Parse and Verify
+type OptsFn func(opts *Config)
+
+// Config holds the options shared by Generate, Parse and Verify
+type Config struct {
+	algorithm string
+	workers   int
+	exclude   []string
+}
+
+// NewConfig creates a Config that defaults to SHA-256 with one worker per CPU
+func NewConfig(o ...OptsFn) *Config {
+	cfg := &Config{
+		algorithm: "sha256",
+		workers:   runtime.NumCPU(),
+	}
+	for _, opts := range o {
+		opts(cfg)
+	}
+	return cfg
+}
+
+// WithAlgorithm selects the hash algorithm by name, see hashing.Lookup
+func WithAlgorithm(name string) OptsFn {
+	return func(opts *Config) {
+		opts.algorithm = name
+	}
+}
+
+// WithWorkers sets how many files are hashed concurrently
+func WithWorkers(n int) OptsFn {
+	return func(opts *Config) {
+		if n > 0 {
+			opts.workers = n
+		}
+	}
+}
+
+// WithExclude skips every file or directory whose name starts with one of the given patterns
+func WithExclude(data ...string) OptsFn {
+	return func(opts *Config) {
+		opts.exclude = append(opts.exclude, data...)
+	}
+}
+
+// Generate hashes every regular file below root and returns a manifest with
+// slash-separated paths relative to root, sorted by path.
+func Generate(fsys afero.Fs, root string, o ...OptsFn) (*Manifest, error) {
+	cfg := NewConfig(o...)
+
+	alg, err := hashing.Lookup(cfg.algorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	paths, err := cfg.walk(fsys, root)
+	if err != nil {
+		return nil, err
+	}
+
+	results := hashFiles(fsys, root, paths, hashing.NewHasher(alg.New), cfg.workers)
+
+	m := &Manifest{Entries: make([]Entry, 0, len(paths))}
+	for i, res := range results {
+		if res.err != nil {
+			return nil, fmt.Errorf("failed to hash %s: %w", paths[i], res.err)
+		}
+		m.Entries = append(m.Entries, Entry{Path: paths[i], Algorithm: alg.Name, Digest: res.digest})
+	}
+	return m, nil
+}
+
+// Encode writes the manifest to w in the given format
+func (m *Manifest) Encode(w io.Writer, format Format) error {
+	bw := bufio.NewWriter(w)
+	for _, e := range m.Entries {
+		line, err := e.format(format)
+		if err != nil {
+			return err
+		}
+		if _, err := bw.WriteString(line + "\n"); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+// String returns the manifest in GNU format
+func (m *Manifest) String() string {
+	var b strings.Builder
+	_ = m.Encode(&b, GNU)
+	return b.String()
+}
+
+func (e Entry) format(format Format) (string, error) {
+	switch format {
+	case GNU:
+		name, escaped := escapePath(e.Path)
+		prefix := ""
+		if escaped {
+			prefix = "\\"
+		}
+		return fmt.Sprintf("%s%s  %s", prefix, e.Digest.Hex(), name), nil
+	case BSD:
+		name, escaped := escapePath(e.Path)
+		prefix := ""
+		if escaped {
+			prefix = "\\"
+		}
+		return fmt.Sprintf("%s%s (%s) = %s", prefix, tagName(e.Algorithm), name, e.Digest.Hex()), nil
+	case SRI:
+		integrity, err := Integrity(e.Algorithm, e.Digest)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s  %s", integrity, e.Path), nil
+	default:
+		return "", fmt.Errorf("unsupported format: %d", format)
+	}
+}
+
+// Parse reads a manifest in GNU, BSD or SRI format; formats may be mixed line by line.
+// Untagged GNU lines carry no algorithm, so it is taken from WithAlgorithm when given
+// and otherwise inferred from the digest length (md5, sha1, sha224, sha256, sha384, sha512).
+// A 64-byte digest is always read as sha512: untagged b2sum output must be parsed with
+// WithAlgorithm("blake2b-512"), or every file will be reported as modified.
+func Parse(r io.Reader, o ...OptsFn) (*Manifest, error) {
+	// Start from an empty config so an unset algorithm can be told apart from the default
+	cfg := &Config{}
+	for _, opts := range o {
+		opts(cfg)
+	}
+
+	m := &Manifest{}
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		e, err := parseLine(line, cfg.algorithm)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", n, err)
+		}
+		m.Entries = append(m.Entries, e)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func parseLine(line, algorithm string) (Entry, error) {
+	escaped := strings.HasPrefix(line, "\\")
+	if escaped {
+		line = line[1:]
+	}
+
+	// BSD: "ALG (path) = hex"
+	if open := strings.Index(line, " ("); open > 0 && !strings.ContainsAny(line[:open], " \t") {
+		if closing := strings.LastIndex(line, ") = "); closing > open {
+			alg, err := hashing.Lookup(line[:open])
+			if err != nil {
+				return Entry{}, err
+			}
+			digest, err := hashing.ParseHex(line[closing+4:])
+			if err != nil {
+				return Entry{}, fmt.Errorf("invalid digest: %w", err)
+			}
+			return Entry{Path: unescapePath(line[open+2:closing], escaped), Algorithm: alg.Name, Digest: digest}, nil
+		}
+	}
+
+	// GNU: "hex  path" or "hex *path" (binary mode); SRI: "alg-base64  path"
+	sep := strings.Index(line, " ")
+	if sep <= 0 || sep+2 > len(line) || (line[sep+1] != ' ' && line[sep+1] != '*') {
+		return Entry{}, errors.New("malformed checksum line")
+	}
+	sum, name := line[:sep], unescapePath(line[sep+2:], escaped)
+
+	if alg, digest, err := ParseIntegrity(sum); err == nil {
+		return Entry{Path: name, Algorithm: alg, Digest: digest}, nil
+	}
+
+	digest, err := hashing.ParseHex(sum)
+	if err != nil {
+		return Entry{}, fmt.Errorf("invalid digest: %w", err)
+	}
+
+	if algorithm == "" {
+		if algorithm = algorithmBySize[len(digest)]; algorithm == "" {
+			return Entry{}, fmt.Errorf("cannot infer algorithm for %d-byte digest", len(digest))
+		}
+	}
+	alg, err := hashing.Lookup(algorithm)
+	if err != nil {
+		return Entry{}, err
+	}
+	return Entry{Path: name, Algorithm: alg.Name, Digest: digest}, nil
+}
+
+// algorithmBySize infers the algorithm of untagged lines, following the *sum tools.
+// Sizes shared by several algorithms map to the SHA one, e.g. 64 bytes is sha512, not blake2b-512.
+var algorithmBySize = map[int]string{
+	16: "md5",
+	20: "sha1",
+	28: "sha224",
+	32: "sha256",
+	48: "sha384",
+	64: "sha512",
+}
+
+// Report is the outcome of verifying a manifest against a directory
+type Report struct {
+	OK       []string
+	Missing  []string
+	Modified []string
+	Extra    []string
+}
+
+// Valid reports whether every listed file exists with a matching digest and no unlisted files were found
+func (r *Report) Valid() bool {
+	return len(r.Missing) == 0 && len(r.Modified) == 0 && len(r.Extra) == 0
+}
+
+// Verify hashes the files listed in the manifest below root and compares them with the
+// recorded digests. Files found below root but absent from the manifest are reported as extra.
+// Absolute paths and paths escaping root through ".." are rejected before anything is read.
+func Verify(fsys afero.Fs, root string, m *Manifest, o ...OptsFn) (*Report, error) {
+	cfg := NewConfig(o...)
+
+	paths := make([]string, len(m.Entries))
+	hashers := make([]*hashing.Hasher, len(m.Entries))
+	listed := make(map[string]bool, len(m.Entries))
+	for i, e := range m.Entries {
+		if !filepath.IsLocal(filepath.FromSlash(e.Path)) {
+			return nil, fmt.Errorf("%s: path is absolute or escapes the root", e.Path)
+		}
+		alg, err := hashing.Lookup(e.Algorithm)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", e.Path, err)
+		}
+		paths[i] = e.Path
+		hashers[i] = hashing.NewHasher(alg.New)
+		listed[path.Clean(e.Path)] = true
+	}
+
+	results := runPool(len(paths), cfg.workers, func(i int) result {
+		return hashFile(fsys, root, paths[i], hashers[i])
+	})
+
+	report := &Report{}
+	for i, res := range results {
+		switch {
+		case errors.Is(res.err, os.ErrNotExist):
+			report.Missing = append(report.Missing, paths[i])
+		case res.err != nil:
+			return nil, fmt.Errorf("failed to hash %s: %w", paths[i], res.err)
+		case !res.digest.Equal(m.Entries[i].Digest):
+			report.Modified = append(report.Modified, paths[i])
+		default:
+			report.OK = append(report.OK, paths[i])
+		}
+	}
+
+	found, err := cfg.walk(fsys, root)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range found {
+		if !listed[p] {
+			report.Extra = append(report.Extra, p)
+		}
+	}
+	return report, nil
+}
+
+// walk lists regular files below root as sorted slash-separated relative paths
+func (c *Config) walk(fsys afero.Fs, root string) ([]string, error) {
+	if fsys == nil {
+		return nil, errors.New("nil filesystem")
+	}
+
+	var paths []string
+	err := afero.Walk(fsys, root, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if p != root && c.isExcluded(info.Name()) {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+		paths = append(paths, filepath.ToSlash(rel))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
+// isExcluded checks if the given name matches any of the configured exclude patterns
+func (c *Config) isExcluded(name string) bool {
+	for _, pattern := range c.exclude {
+		if strings.HasPrefix(name, pattern) {
+			return true
+		}
+	}
+	return false
+}
+
+// escapePath applies the coreutils escaping for names containing backslashes or newlines
+func escapePath(name string) (string, bool) {
+	if !strings.ContainsAny(name, "\\\n\r") {
+		return name, false
+	}
+	r := strings.NewReplacer("\\", "\\\\", "\n", "\\n", "\r", "\\r")
+	return r.Replace(name), true
+}
+
+func unescapePath(name string, escaped bool) string {
+	if !escaped {
+		return name
+	}
+	r := strings.NewReplacer("\\\\", "\\", "\\n", "\n", "\\r", "\r")
+	return r.Replace(name)
+}
+
+// tagName returns the algorithm label used by the coreutils --tag output
+func tagName(algorithm string) string {
+	switch algorithm {
+	case "blake2b-512":
+		return "BLAKE2b"
+	case "blake2b-256", "blake2b-384":
+		return "BLAKE2b" + strings.TrimPrefix(algorithm, "blake2b")
+	case "blake2s-256":
+		return "BLAKE2s-256"
+	default:
+		return strings.ToUpper(algorithm)
+	}
+}
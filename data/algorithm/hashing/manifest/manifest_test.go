@@ -0,0 +1,214 @@
+package manifest
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/spf13/afero"
+)
+
+func newTestFs(t *testing.T) afero.Fs {
+	t.Helper()
+
+	fs := afero.NewMemMapFs()
+	files := map[string]string{
+		"release/test.txt":        "test",
+		"release/bin/app":         "binary",
+		"release/docs/README.md":  "# readme",
+		"release/.git/HEAD":       "ref: refs/heads/main",
+		"release/with\\backslash": "escaped",
+	}
+	for name, content := range files {
+		if err := afero.WriteFile(fs, name, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return fs
+}
+
+func TestGenerateGNU(t *testing.T) {
+	m, err := Generate(newTestFs(t), "release", WithExclude(".git"), WithWorkers(2))
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := m.Encode(&buf, GNU); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08  test.txt\n"
+	if !strings.Contains(buf.String(), want) {
+		t.Errorf("manifest missing sha256sum line %q:\n%s", want, buf.String())
+	}
+
+	if strings.Contains(buf.String(), ".git") {
+		t.Errorf("excluded entries present in manifest:\n%s", buf.String())
+	}
+
+	if !strings.Contains(buf.String(), "\\") || !strings.Contains(buf.String(), "with\\\\backslash") {
+		t.Errorf("backslash in name was not escaped:\n%s", buf.String())
+	}
+}
+
+func TestEncodeParseRoundTrip(t *testing.T) {
+	tests := []struct {
+		name      string
+		algorithm string
+		format    Format
+		contains  string
+	}{
+		{name: "gnu sha256", algorithm: "sha256", format: GNU, contains: "  bin/app"},
+		{name: "bsd sha256", algorithm: "sha256", format: BSD, contains: "SHA256 (bin/app) = "},
+		{name: "bsd blake2b", algorithm: "blake2b", format: BSD, contains: "BLAKE2b (bin/app) = "},
+		{name: "bsd blake2b-256", algorithm: "blake2b-256", format: BSD, contains: "BLAKE2b-256 (bin/app) = "},
+		{name: "sri sha384", algorithm: "sha384", format: SRI, contains: "sha384-"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := newTestFs(t)
+			m, err := Generate(fs, "release", WithAlgorithm(tt.algorithm))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var buf bytes.Buffer
+			if err := m.Encode(&buf, tt.format); err != nil {
+				t.Fatal(err)
+			}
+
+			if !strings.Contains(buf.String(), tt.contains) {
+				t.Errorf("encoded manifest does not contain %q:\n%s", tt.contains, buf.String())
+			}
+
+			parsed, err := Parse(&buf, WithAlgorithm(tt.algorithm))
+			if err != nil {
+				t.Fatalf("Parse failed: %v", err)
+			}
+
+			if !reflect.DeepEqual(parsed.Entries, m.Entries) {
+				t.Errorf("round trip mismatch:\n got %+v\nwant %+v", parsed.Entries, m.Entries)
+			}
+		})
+	}
+}
+
+func TestParseInfersAlgorithm(t *testing.T) {
+	input := "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08 *test.txt\n" +
+		"098f6bcd4621d373cade4e832627b4f6  test.md5\n"
+
+	m, err := Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(m.Entries) != 2 {
+		t.Fatalf("got %d entries, want 2", len(m.Entries))
+	}
+
+	if m.Entries[0].Algorithm != "sha256" || m.Entries[0].Path != "test.txt" {
+		t.Errorf("unexpected first entry: %+v", m.Entries[0])
+	}
+
+	if m.Entries[1].Algorithm != "md5" {
+		t.Errorf("unexpected second entry: %+v", m.Entries[1])
+	}
+
+	if _, err := Parse(strings.NewReader("garbage\n")); err == nil {
+		t.Error("expected error for malformed line")
+	}
+
+	// 64-byte digests are read as sha512 unless the algorithm is given
+	b2sum := strings.Repeat("ab", 64) + "  test.txt\n"
+	for alg, o := range map[string][]OptsFn{"sha512": nil, "blake2b-512": {WithAlgorithm("blake2b-512")}} {
+		m, err := Parse(strings.NewReader(b2sum), o...)
+		if err != nil || m.Entries[0].Algorithm != alg {
+			t.Errorf("Parse() = %+v, %v; want %s", m, err, alg)
+		}
+	}
+}
+
+func TestVerify(t *testing.T) {
+	fs := newTestFs(t)
+	m, err := Generate(fs, "release", WithExclude(".git"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	report, err := Verify(fs, "release", m, WithExclude(".git"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !report.Valid() {
+		t.Fatalf("untouched tree did not verify: %+v", report)
+	}
+
+	if err := afero.WriteFile(fs, "release/bin/app", []byte("tampered"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := fs.Remove("release/docs/README.md"); err != nil {
+		t.Fatal(err)
+	}
+	if err := afero.WriteFile(fs, "release/new.txt", []byte("new"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	report, err = Verify(fs, "release", m, WithExclude(".git"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if report.Valid() {
+		t.Fatal("modified tree verified")
+	}
+	if !reflect.DeepEqual(report.Modified, []string{"bin/app"}) {
+		t.Errorf("Modified = %v", report.Modified)
+	}
+	if !reflect.DeepEqual(report.Missing, []string{"docs/README.md"}) {
+		t.Errorf("Missing = %v", report.Missing)
+	}
+	if !reflect.DeepEqual(report.Extra, []string{"new.txt"}) {
+		t.Errorf("Extra = %v", report.Extra)
+	}
+}
+
+func TestVerifyRejectsEscapingPaths(t *testing.T) {
+	fs := newTestFs(t)
+	if err := afero.WriteFile(fs, "secret", []byte("test"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, name := range []string{"../secret", "bin/../../secret", "/secret", ""} {
+		m := &Manifest{Entries: []Entry{{Path: name, Algorithm: "sha256"}}}
+		if _, err := Verify(fs, "release", m); err == nil {
+			t.Errorf("Verify(%q) should fail", name)
+		}
+	}
+}
+
+func TestIntegrity(t *testing.T) {
+	m, err := Generate(newTestFs(t), "release", WithAlgorithm("sha384"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	integrity, err := Integrity(m.Entries[0].Algorithm, m.Entries[0].Digest)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	alg, digest, err := ParseIntegrity(integrity)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if alg != "sha384" || !digest.Equal(m.Entries[0].Digest) {
+		t.Errorf("ParseIntegrity(%q) = %s %x", integrity, alg, digest)
+	}
+
+	if _, err := Integrity("md5", digest); err == nil {
+		t.Error("expected error for md5 integrity")
+	}
+}
@@ -0,0 +1,62 @@
+package manifest
+
+import (
+	"bufio"
+	"path/filepath"
+	"sync"
+
+	"github.com/inovacc/toolkit/data/algorithm/hashing"
+	"github.com/spf13/afero"
+)
+
+type result struct {
+	digest hashing.Digest
+	err    error
+}
+
+// hashFiles hashes every path below root with the same hasher using a pool of workers
+func hashFiles(fsys afero.Fs, root string, paths []string, hasher *hashing.Hasher, workers int) []result {
+	return runPool(len(paths), workers, func(i int) result {
+		return hashFile(fsys, root, paths[i], hasher)
+	})
+}
+
+// runPool runs fn for every index in [0, n) on at most workers goroutines and
+// returns the results in index order
+func runPool(n, workers int, fn func(i int) result) []result {
+	results := make([]result, n)
+	if workers > n {
+		workers = n
+	}
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				results[i] = fn(i)
+			}
+		}()
+	}
+
+	for i := 0; i < n; i++ {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return results
+}
+
+func hashFile(fsys afero.Fs, root, name string, hasher *hashing.Hasher) result {
+	file, err := fsys.Open(filepath.Join(root, filepath.FromSlash(name)))
+	if err != nil {
+		return result{err: err}
+	}
+	defer file.Close()
+
+	digest, err := hasher.SumReader(bufio.NewReaderSize(file, 64*1024))
+	return result{digest: digest, err: err}
+}
@@ -0,0 +1,47 @@
+package manifest
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/inovacc/toolkit/data/algorithm/hashing"
+)
+
+// sriAlgorithms are the hash algorithms allowed by the Subresource Integrity specification
+var sriAlgorithms = map[string]bool{
+	"sha256": true,
+	"sha384": true,
+	"sha512": true,
+}
+
+// Integrity returns the Subresource Integrity string for a digest, e.g. "sha384-oqVuAfXR..."
+func Integrity(algorithm string, digest hashing.Digest) (string, error) {
+	alg, err := hashing.Lookup(algorithm)
+	if err != nil {
+		return "", err
+	}
+	if !sriAlgorithms[alg.Name] {
+		return "", fmt.Errorf("algorithm %s is not allowed in subresource integrity", alg.Name)
+	}
+	return alg.Name + "-" + digest.Base64(), nil
+}
+
+// ParseIntegrity splits a Subresource Integrity string into its algorithm and digest
+func ParseIntegrity(s string) (string, hashing.Digest, error) {
+	name, encoded, ok := strings.Cut(strings.TrimSpace(s), "-")
+	if !ok || !sriAlgorithms[name] {
+		return "", nil, fmt.Errorf("invalid integrity string: %q", s)
+	}
+
+	digest, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid integrity digest: %w", err)
+	}
+
+	alg, _ := hashing.Lookup(name)
+	if len(digest) != hashing.NewHasher(alg.New).GetSize() {
+		return "", nil, fmt.Errorf("integrity digest has wrong length for %s", name)
+	}
+	return name, digest, nil
+}
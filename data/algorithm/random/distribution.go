@@ -0,0 +1,249 @@
+package random
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+)
+
+// Sampler draws values from a probability distribution. Samplers are built
+// from a Source chosen with Option values, crypto/rand by default, and are not
+// safe for concurrent use unless that Source is.
+type Sampler interface {
+	Sample() float64
+}
+
+// Uniform samples uniformly from [Min, Max).
+type Uniform struct {
+	Min, Max float64
+	rng      *rand.Rand
+}
+
+// NewUniform creates a Uniform sampler over [min, max).
+func NewUniform(min, max float64, opts ...Option) (*Uniform, error) {
+	if !finite(min, max) || min >= max {
+		return nil, fmt.Errorf("invalid uniform range [%g, %g)", min, max)
+	}
+	return &Uniform{Min: min, Max: max, rng: NewRand(opts...)}, nil
+}
+
+func (d *Uniform) Sample() float64 {
+	return d.Min + d.rng.Float64()*(d.Max-d.Min)
+}
+
+// Normal samples from a Gaussian distribution.
+type Normal struct {
+	Mean, StdDev float64
+	rng          *rand.Rand
+}
+
+// NewNormal creates a Normal sampler. stddev must be positive.
+func NewNormal(mean, stddev float64, opts ...Option) (*Normal, error) {
+	if !finite(mean, stddev) || stddev <= 0 {
+		return nil, fmt.Errorf("invalid normal parameters: mean %g, stddev %g", mean, stddev)
+	}
+	return &Normal{Mean: mean, StdDev: stddev, rng: NewRand(opts...)}, nil
+}
+
+func (d *Normal) Sample() float64 {
+	return d.Mean + d.rng.NormFloat64()*d.StdDev
+}
+
+// LogNormal samples values whose logarithm is normally distributed with
+// parameters Mu and Sigma. It models sizes and latencies with a long right tail.
+type LogNormal struct {
+	Mu, Sigma float64
+	rng       *rand.Rand
+}
+
+// NewLogNormal creates a LogNormal sampler. sigma must be positive.
+func NewLogNormal(mu, sigma float64, opts ...Option) (*LogNormal, error) {
+	if !finite(mu, sigma) || sigma <= 0 {
+		return nil, fmt.Errorf("invalid log-normal parameters: mu %g, sigma %g", mu, sigma)
+	}
+	return &LogNormal{Mu: mu, Sigma: sigma, rng: NewRand(opts...)}, nil
+}
+
+func (d *LogNormal) Sample() float64 {
+	return math.Exp(d.Mu + d.rng.NormFloat64()*d.Sigma)
+}
+
+// Exponential samples the waiting time between events of a Poisson process
+// with the given Rate, e.g. request inter-arrival times. The mean is 1/Rate.
+type Exponential struct {
+	Rate float64
+	rng  *rand.Rand
+}
+
+// NewExponential creates an Exponential sampler. rate must be positive.
+func NewExponential(rate float64, opts ...Option) (*Exponential, error) {
+	if !finite(rate) || rate <= 0 {
+		return nil, fmt.Errorf("invalid exponential rate %g", rate)
+	}
+	return &Exponential{Rate: rate, rng: NewRand(opts...)}, nil
+}
+
+func (d *Exponential) Sample() float64 {
+	return d.rng.ExpFloat64() / d.Rate
+}
+
+// Pareto samples from a Pareto distribution with scale Xm and shape Alpha.
+// Values are at least Xm; smaller Alpha gives a heavier tail.
+type Pareto struct {
+	Xm, Alpha float64
+	rng       *rand.Rand
+}
+
+// NewPareto creates a Pareto sampler. xm and alpha must be positive.
+func NewPareto(xm, alpha float64, opts ...Option) (*Pareto, error) {
+	if !finite(xm, alpha) || xm <= 0 || alpha <= 0 {
+		return nil, fmt.Errorf("invalid pareto parameters: xm %g, alpha %g", xm, alpha)
+	}
+	return &Pareto{Xm: xm, Alpha: alpha, rng: NewRand(opts...)}, nil
+}
+
+func (d *Pareto) Sample() float64 {
+	// 1 - Float64() lies in (0, 1], so the power is always finite
+	return d.Xm / math.Pow(1-d.rng.Float64(), 1/d.Alpha)
+}
+
+// Poisson samples the number of events in an interval with mean Lambda.
+type Poisson struct {
+	Lambda float64
+	rng    *rand.Rand
+}
+
+// NewPoisson creates a Poisson sampler. lambda must be positive.
+func NewPoisson(lambda float64, opts ...Option) (*Poisson, error) {
+	if !finite(lambda) || lambda <= 0 {
+		return nil, fmt.Errorf("invalid poisson lambda %g", lambda)
+	}
+	return &Poisson{Lambda: lambda, rng: NewRand(opts...)}, nil
+}
+
+func (d *Poisson) Sample() float64 {
+	return float64(d.Int())
+}
+
+// Int returns the next sample as an integer.
+func (d *Poisson) Int() int {
+	if d.Lambda < 30 {
+		return d.knuth()
+	}
+	return d.ptrs()
+}
+
+// knuth multiplies uniforms until the product drops below e^-lambda; O(lambda) per sample.
+func (d *Poisson) knuth() int {
+	limit := math.Exp(-d.Lambda)
+	k, p := 0, d.rng.Float64()
+	for p > limit {
+		k++
+		p *= d.rng.Float64()
+	}
+	return k
+}
+
+// ptrs is Hörmann's transformed rejection with squeeze, O(1) for large lambda.
+func (d *Poisson) ptrs() int {
+	lambda := d.Lambda
+	slam := math.Sqrt(lambda)
+	loglam := math.Log(lambda)
+	b := 0.931 + 2.53*slam
+	a := -0.059 + 0.02483*b
+	invalpha := 1.1239 + 1.1328/(b-3.4)
+	vr := 0.9277 - 3.6224/(b-2)
+
+	for {
+		u := d.rng.Float64() - 0.5
+		v := d.rng.Float64()
+		us := 0.5 - math.Abs(u)
+		k := math.Floor((2*a/us+b)*u + lambda + 0.43)
+		if us >= 0.07 && v <= vr {
+			return int(k)
+		}
+		if k < 0 || (us < 0.013 && v > us) {
+			continue
+		}
+		lg, _ := math.Lgamma(k + 1)
+		if math.Log(v)+math.Log(invalpha)-math.Log(a/(us*us)+b) <= -lambda+k*loglam-lg {
+			return int(k)
+		}
+	}
+}
+
+// Zipf samples ranks in [0, Imax] where rank k has probability proportional
+// to (V + k)^(-S). It models popularity, e.g. which keys a cache sees most.
+type Zipf struct {
+	S, V float64
+	Imax uint64
+	zipf *rand.Zipf
+}
+
+// NewZipf creates a Zipf sampler. s must be greater than 1 and v at least 1.
+func NewZipf(s, v float64, imax uint64, opts ...Option) (*Zipf, error) {
+	if !finite(s, v) || s <= 1 || v < 1 {
+		return nil, fmt.Errorf("invalid zipf parameters: s %g, v %g", s, v)
+	}
+	return &Zipf{S: s, V: v, Imax: imax, zipf: rand.NewZipf(NewRand(opts...), s, v, imax)}, nil
+}
+
+func (d *Zipf) Sample() float64 {
+	return float64(d.zipf.Uint64())
+}
+
+// Uint64 returns the next rank.
+func (d *Zipf) Uint64() uint64 {
+	return d.zipf.Uint64()
+}
+
+// Empirical samples from an observed histogram: a bin is chosen in proportion
+// to its count and a value is drawn uniformly within it.
+type Empirical struct {
+	edges []float64
+	bins  *Weighted[int]
+	rng   *rand.Rand
+}
+
+// NewEmpirical creates a sampler for a histogram with len(edges)-1 bins, where
+// bin i covers [edges[i], edges[i+1]) and holds counts[i] observations.
+func NewEmpirical(edges, counts []float64, opts ...Option) (*Empirical, error) {
+	if len(edges) < 2 {
+		return nil, errors.New("a histogram needs at least two edges")
+	}
+	if len(counts) != len(edges)-1 {
+		return nil, fmt.Errorf("got %d counts for %d bins", len(counts), len(edges)-1)
+	}
+	for i := 1; i < len(edges); i++ {
+		if !finite(edges[i-1], edges[i]) || edges[i] <= edges[i-1] {
+			return nil, fmt.Errorf("histogram edges must be finite and increasing at index %d", i)
+		}
+	}
+
+	src := NewOptions(opts...).Source
+	idx := make([]int, len(counts))
+	for i := range idx {
+		idx[i] = i
+	}
+	bins, err := NewWeighted(idx, counts, WithSource(src))
+	if err != nil {
+		return nil, err
+	}
+	return &Empirical{edges: append([]float64(nil), edges...), bins: bins, rng: rand.New(src)}, nil
+}
+
+func (d *Empirical) Sample() float64 {
+	i := d.bins.Pick()
+	lo, hi := d.edges[i], d.edges[i+1]
+	return lo + d.rng.Float64()*(hi-lo)
+}
+
+func finite(values ...float64) bool {
+	for _, v := range values {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return false
+		}
+	}
+	return true
+}
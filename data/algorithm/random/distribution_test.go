@@ -0,0 +1,110 @@
+package random
+
+import (
+	"math"
+	"testing"
+)
+
+func mean(s Sampler, n int) float64 {
+	sum := 0.0
+	for i := 0; i < n; i++ {
+		sum += s.Sample()
+	}
+	return sum / float64(n)
+}
+
+func TestDistributionMeans(t *testing.T) {
+	uniform, _ := NewUniform(10, 20, WithSeed(1))
+	normal, _ := NewNormal(100, 15, WithSeed(1))
+	logNormal, _ := NewLogNormal(0, 0.5, WithSeed(1))
+	exponential, _ := NewExponential(4, WithSeed(1))
+	pareto, _ := NewPareto(1, 3, WithSeed(1))
+	poissonSmall, _ := NewPoisson(3.5, WithSeed(1))
+	poissonLarge, _ := NewPoisson(120, WithSeed(1))
+	empirical, _ := NewEmpirical([]float64{0, 10, 20}, []float64{1, 3}, WithSeed(1))
+
+	tests := []struct {
+		name    string
+		sampler Sampler
+		want    float64
+		tol     float64
+	}{
+		{name: "uniform", sampler: uniform, want: 15, tol: 0.1},
+		{name: "normal", sampler: normal, want: 100, tol: 0.5},
+		{name: "log-normal", sampler: logNormal, want: math.Exp(0.125), tol: 0.02},
+		{name: "exponential", sampler: exponential, want: 0.25, tol: 0.01},
+		{name: "pareto", sampler: pareto, want: 1.5, tol: 0.03},
+		{name: "poisson small", sampler: poissonSmall, want: 3.5, tol: 0.05},
+		{name: "poisson large", sampler: poissonLarge, want: 120, tol: 0.5},
+		{name: "empirical", sampler: empirical, want: 12.5, tol: 0.15},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mean(tt.sampler, 100000); math.Abs(got-tt.want) > tt.tol {
+				t.Errorf("mean = %g, want %g ± %g", got, tt.want, tt.tol)
+			}
+		})
+	}
+}
+
+func TestZipf(t *testing.T) {
+	z, err := NewZipf(1.2, 1, 1000, WithSeed(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	counts := make(map[uint64]int)
+	for i := 0; i < 10000; i++ {
+		v := z.Uint64()
+		if v > 1000 {
+			t.Fatalf("rank %d above imax", v)
+		}
+		counts[v]++
+	}
+
+	if counts[0] <= counts[1] || counts[1] <= counts[10] {
+		t.Errorf("ranks are not decreasing in popularity: %d, %d, %d", counts[0], counts[1], counts[10])
+	}
+}
+
+func TestDistributionsReproducible(t *testing.T) {
+	a, _ := NewLogNormal(1, 1, WithSeed(11))
+	b, _ := NewLogNormal(1, 1, WithSeed(11))
+
+	for i := 0; i < 10; i++ {
+		if a.Sample() != b.Sample() {
+			t.Fatal("seeded samplers diverged")
+		}
+	}
+}
+
+func TestDistributionErrors(t *testing.T) {
+	if _, err := NewUniform(1, 1); err == nil {
+		t.Error("uniform: expected error")
+	}
+	if _, err := NewNormal(0, 0); err == nil {
+		t.Error("normal: expected error")
+	}
+	if _, err := NewLogNormal(0, -1); err == nil {
+		t.Error("log-normal: expected error")
+	}
+	if _, err := NewExponential(0); err == nil {
+		t.Error("exponential: expected error")
+	}
+	if _, err := NewPareto(0, 1); err == nil {
+		t.Error("pareto: expected error")
+	}
+	if _, err := NewPoisson(math.NaN()); err == nil {
+		t.Error("poisson: expected error")
+	}
+	if _, err := NewZipf(1, 1, 10); err == nil {
+		t.Error("zipf: expected error")
+	}
+	if _, err := NewEmpirical([]float64{0, 1}, []float64{1, 2}); err == nil {
+		t.Error("empirical: expected error for mismatched counts")
+	}
+	if _, err := NewEmpirical([]float64{1, 0}, []float64{1}); err == nil {
+		t.Error("empirical: expected error for decreasing edges")
+	}
+}
@@ -1,42 +1,117 @@
 package random
 
 import (
-	"crypto/rand"
+	crand "crypto/rand"
 	"fmt"
-	"math/big"
+	"math/rand/v2"
 )
 
-// RandomString generates a random alphanumeric string of length `n`.
-// It uses crypto/rand for cryptographically secure randomness.
-func RandomString(n int) string {
-	const letters = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+const alphanumeric = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+// Generator produces random values from a single Source.
+type Generator struct {
+	src Source
+	rng *rand.Rand
+}
+
+// New creates a Generator drawing from src.
+func New(src Source) *Generator {
+	return &Generator{
+		src: src,
+		rng: rand.New(src),
+	}
+}
+
+// NewCrypto creates a Generator backed by crypto/rand.
+func NewCrypto() *Generator {
+	return New(NewCryptoSource())
+}
+
+// NewSeeded creates a reproducible Generator; the same seed always yields the same values.
+func NewSeeded(seed uint64) *Generator {
+	return New(NewSeededSource(seed))
+}
+
+// defaultGenerator backs the package-level functions with crypto/rand.
+var defaultGenerator = NewCrypto()
+
+// Source returns the Source the generator draws from.
+func (g *Generator) Source() Source {
+	return g.src
+}
+
+// Rand returns a *rand.Rand drawing from the generator's Source.
+func (g *Generator) Rand() *rand.Rand {
+	return g.rng
+}
+
+// String generates a random alphanumeric string of length `n`.
+func (g *Generator) String(n int) string {
 	b := make([]byte, n)
 	for i := range b {
-		// Secure a random selection of an index from letters
-		num, _ := rand.Int(rand.Reader, big.NewInt(int64(len(letters))))
-		b[i] = letters[num.Int64()]
+		b[i] = alphanumeric[g.rng.IntN(len(alphanumeric))]
 	}
 	return string(b)
 }
 
-// RandomInt returns a cryptographically secure random integer between `min` and `max`.
+// Int returns a random integer between `min` (inclusive) and `max` (exclusive).
 // Returns an error if min >= max.
-func RandomInt(min, max int) (int, error) {
+func (g *Generator) Int(min, max int) (int, error) {
 	if min >= max {
 		return 0, fmt.Errorf("invalid range: min (%d) must be less than max (%d)", min, max)
 	}
-	diff := max - min
-	num, _ := rand.Int(rand.Reader, big.NewInt(int64(diff)))
-	return int(num.Int64()) + min, nil
+	return g.rng.IntN(max-min) + min, nil
 }
 
-// RandomBytes generates a slice of `n` random bytes using crypto/rand.
-// Returns an error if random byte generation fails.
-func RandomBytes(n uint32) ([]byte, error) {
+// IntN returns a random integer in [0, n). It panics if n <= 0.
+func (g *Generator) IntN(n int) int {
+	return g.rng.IntN(n)
+}
+
+// Uint64 returns a random 64-bit value.
+func (g *Generator) Uint64() uint64 {
+	return g.src.Uint64()
+}
+
+// Float64 returns a random float in [0.0, 1.0).
+func (g *Generator) Float64() float64 {
+	return g.rng.Float64()
+}
+
+// Bytes generates a slice of `n` random bytes.
+func (g *Generator) Bytes(n uint32) ([]byte, error) {
 	b := make([]byte, n)
-	_, err := rand.Read(b)
-	if err != nil {
-		return nil, fmt.Errorf("failed to generate random bytes: %w", err)
+	if _, ok := g.src.(cryptoSource); ok {
+		if _, err := crand.Read(b); err != nil {
+			return nil, fmt.Errorf("failed to generate random bytes: %w", err)
+		}
+		return b, nil
+	}
+
+	for i := 0; i < len(b); i += 8 {
+		v := g.src.Uint64()
+		for j := i; j < i+8 && j < len(b); j++ {
+			b[j] = byte(v)
+			v >>= 8
+		}
 	}
 	return b, nil
 }
+
+// RandomString generates a random alphanumeric string of length `n`.
+// It uses crypto/rand for cryptographically secure randomness.
+func RandomString(n int) string {
+	return defaultGenerator.String(n)
+}
+
+// RandomInt returns a cryptographically secure random integer between `min` and `max`.
+// Returns an error if min >= max.
+func RandomInt(min, max int) (int, error) {
+	return defaultGenerator.Int(min, max)
+}
+
+// RandomBytes generates a slice of `n` random bytes using crypto/rand.
+// Returns an error if random byte generation fails.
+func RandomBytes(n uint32) ([]byte, error) {
+	return defaultGenerator.Bytes(n)
+}
@@ -2,12 +2,38 @@
 package randomstring
 
 import (
-	"math/rand"
+	"math/rand/v2"
+	"sort"
 	"strings"
-	"time"
+	"sync/atomic"
+
+	"github.com/inovacc/toolkit/data/algorithm/random"
 )
 
-var random = rand.New(rand.NewSource(1))
+// Generator produces random strings from a single random.Source.
+type Generator struct {
+	rng *rand.Rand
+}
+
+// New creates a Generator drawing from src. Use random.NewSeededSource for
+// reproducible output and random.NewCryptoSource for secure output.
+func New(src random.Source) *Generator {
+	return &Generator{rng: rand.New(src)}
+}
+
+// defaultGenerator backs the package-level functions. It is swapped
+// atomically so SetSource may race with calls from other goroutines.
+var defaultGenerator atomic.Pointer[Generator]
+
+func init() {
+	defaultGenerator.Store(New(random.NewCryptoSource()))
+}
+
+// SetSource replaces the source used by the package-level functions.
+// It is safe to call while other goroutines generate strings.
+func SetSource(src random.Source) {
+	defaultGenerator.Store(New(src))
+}
 
 var freq = map[rune]int{
 	'e': 21912,
@@ -70,98 +96,120 @@ var freqCons = map[rune]int{
 	'z': 128,
 }
 
-// freqsum is a sum of all the frequencies in the freq map
-var freqsum = func() int {
-	n := 0
-	for _, v := range freq {
-		n += v
-	}
-	return n
-}()
+// weighted is a letter table sorted by rune, so picks are reproducible for a seeded source
+type weighted struct {
+	letters []rune
+	weights []int
+	sum     int
+}
 
-// freqsumVowel is a sum of all the frequencies in the freqVowel map
-var freqsumVowel = func() int {
-	n := 0
-	for _, v := range freqVowel {
-		n += v
+func newWeighted(freqs map[rune]int) weighted {
+	w := weighted{}
+	for k := range freqs {
+		w.letters = append(w.letters, k)
+	}
+	sort.Slice(w.letters, func(i, j int) bool { return w.letters[i] < w.letters[j] })
+	for _, k := range w.letters {
+		w.weights = append(w.weights, freqs[k])
+		w.sum += freqs[k]
 	}
-	return n
-}()
+	return w
+}
 
-// freqsumCons is a sum of all the frequencies in the freqCons map
-var freqsumCons = func() int {
+func (w weighted) pick(rng *rand.Rand) rune {
+	target := rng.IntN(w.sum)
 	n := 0
-	for _, v := range freqCons {
+	for i, v := range w.weights {
 		n += v
+		if target < n {
+			return w.letters[i]
+		}
 	}
-	return n
-}()
+	return w.letters[len(w.letters)-1]
+}
+
+var (
+	letterTable = newWeighted(freq)
+	vowelTable  = newWeighted(freqVowel)
+	consTable   = newWeighted(freqCons)
+)
 
 // PickLetter will pick a letter, weighted by the frequency table
 func PickLetter() rune {
-	target := random.Intn(freqsum)
-	selected := 'a'
-	n := 0
-	for k, v := range freq {
-		n += v
-		if n >= target {
-			selected = k
-			break
-		}
-	}
-	return selected
+	return defaultGenerator.Load().PickLetter()
 }
 
 // PickVowel will pick a vowel, weighted by the frequency table
 func PickVowel() rune {
-	target := random.Intn(freqsumVowel)
-	selected := 'a'
-	n := 0
-	for k, v := range freqVowel {
-		n += v
-		if n >= target {
-			selected = k
-			break
-		}
-	}
-	return selected
+	return defaultGenerator.Load().PickVowel()
 }
 
 // PickCons will pick a consonant, weighted by the frequency table
 func PickCons() rune {
-	target := random.Intn(freqsumCons)
-	selected := 't'
-	n := 0
-	for k, v := range freqCons {
-		n += v
-		if n >= target {
-			selected = k
-			break
-		}
-	}
-	return selected
+	return defaultGenerator.Load().PickCons()
 }
 
-// Seed the random number generator in one of many possible ways.
+// Seed the package-level functions with a time-based source, so output differs between runs.
 func Seed() {
-	random = rand.New(rand.NewSource(time.Now().UTC().UnixNano() + 1337))
+	SetSource(random.NewTimeSource())
 }
 
 // String generates a random string of a given length.
 func String(length int) string {
+	return defaultGenerator.Load().String(length)
+}
+
+// EnglishFrequencyString returns a random string that uses the letter frequency of English.
+func EnglishFrequencyString(length int) string {
+	return defaultGenerator.Load().EnglishFrequencyString(length)
+}
+
+// HumanFriendlyString generates a random, but human-friendly, string of the given length.
+func HumanFriendlyString(length int) string {
+	return defaultGenerator.Load().HumanFriendlyString(length)
+}
+
+// CookieFriendlyString generates a random, but cookie-friendly, string of the given length.
+func CookieFriendlyString(length int) string {
+	return defaultGenerator.Load().CookieFriendlyString(length)
+}
+
+// HumanFriendlyEnglishString generates a random, but human-friendly, string of the given length,
+// with vowels and consonants weighted by the frequency table.
+func HumanFriendlyEnglishString(length int) string {
+	return defaultGenerator.Load().HumanFriendlyEnglishString(length)
+}
+
+// PickLetter will pick a letter, weighted by the frequency table
+func (g *Generator) PickLetter() rune {
+	return letterTable.pick(g.rng)
+}
+
+// PickVowel will pick a vowel, weighted by the frequency table
+func (g *Generator) PickVowel() rune {
+	return vowelTable.pick(g.rng)
+}
+
+// PickCons will pick a consonant, weighted by the frequency table
+func (g *Generator) PickCons() rune {
+	return consTable.pick(g.rng)
+}
+
+// String generates a random string of a given length.
+func (g *Generator) String(length int) string {
 	b := make([]byte, length)
 	for i := 0; i < length; i++ {
-		b[i] = byte(random.Int63() & 0xff)
+		b[i] = byte(g.rng.Uint64() & 0xff)
 	}
 	return string(b)
 }
 
 // EnglishFrequencyString returns a random string that uses the letter frequency of English,
 // ref: http://pi.math.cornell.edu/~mec/2003-2004/cryptography/subs/frequencies.html
-func EnglishFrequencyString(length int) string {
+func (g *Generator) EnglishFrequencyString(length int) string {
 	var sb strings.Builder
 	for i := 0; i < length; i++ {
-		sb.WriteRune(PickLetter())
+		sb.WriteRune(g.PickLetter())
 	}
 	return sb.String()
 }
@@ -174,21 +222,21 @@ func EnglishFrequencyString(length int) string {
  *
  * Example output for length 7: rabunor
  */
-func HumanFriendlyString(length int) string {
+func (g *Generator) HumanFriendlyString(length int) string {
 	const (
 		someVowels     = "aeoiu"          // a selection of vowels. email+browsers didn't like "æøå" too much
 		someConsonants = "bdfgklmnoprstv" // a selection of consonants
 		moreLetters    = "chjqwxyz"       // the rest of the letters from a-z
 	)
-	vowelOffset := random.Intn(2)
+	vowelOffset := g.rng.IntN(2)
 	vowelDistribution := 2
 	b := make([]byte, length)
 	for i := 0; i < length; i++ {
 	again:
 		if (i+vowelOffset)%vowelDistribution == 0 {
-			b[i] = someVowels[random.Intn(len(someVowels))]
-		} else if random.Intn(100) > 0 { // 99 of 100 times
-			b[i] = someConsonants[random.Intn(len(someConsonants))]
+			b[i] = someVowels[g.rng.IntN(len(someVowels))]
+		} else if g.rng.IntN(100) > 0 { // 99 of 100 times
+			b[i] = someConsonants[g.rng.IntN(len(someConsonants))]
 			// Don't repeat
 			if i >= 1 && b[i] == b[i-1] {
 				// Also use more vowels
@@ -197,7 +245,7 @@ func HumanFriendlyString(length int) string {
 				goto again
 			}
 		} else {
-			b[i] = moreLetters[random.Intn(len(moreLetters))]
+			b[i] = moreLetters[g.rng.IntN(len(moreLetters))]
 			// Don't repeat
 			if i >= 1 && b[i] == b[i-1] {
 				// Also use more vowels
@@ -217,11 +265,11 @@ func HumanFriendlyString(length int) string {
 
 // CookieFriendlyString generates a random, but cookie-friendly, string of
 // the given length.
-func CookieFriendlyString(length int) string {
+func (g *Generator) CookieFriendlyString(length int) string {
 	const allowed = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
 	b := make([]byte, length)
 	for i := 0; i < length; i++ {
-		b[i] = allowed[random.Intn(len(allowed))]
+		b[i] = allowed[g.rng.IntN(len(allowed))]
 	}
 	return string(b)
 }
@@ -232,16 +280,16 @@ func CookieFriendlyString(length int) string {
  *
  * The vowels and consontants are wighted by the frequency table
  */
-func HumanFriendlyEnglishString(length int) string {
-	vowelOffset := random.Intn(2)
+func (g *Generator) HumanFriendlyEnglishString(length int) string {
+	vowelOffset := g.rng.IntN(2)
 	vowelDistribution := 2
 	b := make([]byte, length)
 	for i := 0; i < length; i++ {
 	again:
 		if (i+vowelOffset)%vowelDistribution == 0 {
-			b[i] = byte(PickVowel())
-		} else if random.Intn(100) > 0 { // 99 of 100 times
-			b[i] = byte(PickCons())
+			b[i] = byte(g.PickVowel())
+		} else if g.rng.IntN(100) > 0 { // 99 of 100 times
+			b[i] = byte(g.PickCons())
 			// Don't repeat
 			if i >= 1 && b[i] == b[i-1] {
 				// Also use more vowels
@@ -250,7 +298,7 @@ func HumanFriendlyEnglishString(length int) string {
 				goto again
 			}
 		} else {
-			b[i] = byte(PickLetter())
+			b[i] = byte(g.PickLetter())
 			// Don't repeat
 			if i >= 1 && b[i] == b[i-1] {
 				// Also use more vowels
@@ -2,7 +2,10 @@ package randomstring
 
 import (
 	"fmt"
+	"sync"
 	"testing"
+
+	"github.com/inovacc/toolkit/data/algorithm/random"
 )
 
 // Uncomment this function to see that rand.NewSource(1) is the same as the unseeded behavior before Go 1.20
@@ -31,3 +34,40 @@ func TestHumanFriendlyEnglishString(t *testing.T) {
 	fmt.Printf("%s\n", HumanFriendlyEnglishString(7))
 	fmt.Printf("%s\n", HumanFriendlyEnglishString(20))
 }
+
+func TestGeneratorSeeded(t *testing.T) {
+	a := New(random.NewSeededSource(1))
+	b := New(random.NewSeededSource(1))
+
+	if a.HumanFriendlyEnglishString(20) != b.HumanFriendlyEnglishString(20) {
+		t.Error("same seed produced different strings")
+	}
+
+	if a.EnglishFrequencyString(20) != b.EnglishFrequencyString(20) {
+		t.Error("same seed produced different frequency strings")
+	}
+}
+
+func TestSetSourceConcurrent(t *testing.T) {
+	defer SetSource(random.NewCryptoSource())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				SetSource(random.NewSeededSource(uint64(j)))
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				if s := String(8); len(s) != 8 {
+					t.Errorf("String(8) = %q", s)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,133 @@
+package randomstring
+
+import (
+	"fmt"
+	"regexp/syntax"
+	"strings"
+	"unicode"
+)
+
+// printable is the range used for '.' and to narrow negated classes such as [^0-9]
+var printable = []rune{0x20, 0x7e}
+
+// FromRegex generates a random string matching pattern, written in Go (RE2) syntax.
+// Unbounded repetitions such as '*', '+' and '{n,}' add at most maxRepeat extra
+// occurrences. Anchors produce no output; word boundaries (\b, \B) are accepted
+// but not enforced, so patterns relying on them may yield non-matching strings.
+//
+// Example: FromRegex(`^[A-Z]{3}-\d{4}$`, 10) might return "QKD-0381".
+func FromRegex(pattern string, maxRepeat int) (string, error) {
+	return defaultGenerator.Load().FromRegex(pattern, maxRepeat)
+}
+
+// FromRegex generates a random string matching pattern, see the package-level FromRegex.
+func (g *Generator) FromRegex(pattern string, maxRepeat int) (string, error) {
+	if maxRepeat < 0 {
+		return "", fmt.Errorf("maxRepeat must not be negative, got %d", maxRepeat)
+	}
+
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	if err := g.regex(&sb, re, maxRepeat); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
+
+func (g *Generator) regex(sb *strings.Builder, re *syntax.Regexp, maxRepeat int) error {
+	switch re.Op {
+	case syntax.OpNoMatch:
+		return fmt.Errorf("pattern %s can never match", re)
+	case syntax.OpEmptyMatch, syntax.OpBeginLine, syntax.OpEndLine,
+		syntax.OpBeginText, syntax.OpEndText, syntax.OpWordBoundary, syntax.OpNoWordBoundary:
+		return nil
+	case syntax.OpLiteral:
+		for _, r := range re.Rune {
+			if re.Flags&syntax.FoldCase != 0 && g.rng.IntN(2) == 0 {
+				r = unicode.SimpleFold(r)
+			}
+			sb.WriteRune(r)
+		}
+	case syntax.OpCharClass:
+		if len(re.Rune) == 0 {
+			return fmt.Errorf("character class %s matches no character", re)
+		}
+		sb.WriteRune(g.pickRange(narrow(re.Rune)))
+	case syntax.OpAnyCharNotNL, syntax.OpAnyChar:
+		sb.WriteRune(g.pickRange(printable))
+	case syntax.OpCapture:
+		return g.regex(sb, re.Sub[0], maxRepeat)
+	case syntax.OpStar:
+		return g.repeat(sb, re.Sub[0], 0, maxRepeat, maxRepeat)
+	case syntax.OpPlus:
+		return g.repeat(sb, re.Sub[0], 1, 1+maxRepeat, maxRepeat)
+	case syntax.OpQuest:
+		return g.repeat(sb, re.Sub[0], 0, 1, maxRepeat)
+	case syntax.OpRepeat:
+		hi := re.Max
+		if hi < 0 {
+			hi = re.Min + maxRepeat
+		}
+		return g.repeat(sb, re.Sub[0], re.Min, hi, maxRepeat)
+	case syntax.OpConcat:
+		for _, sub := range re.Sub {
+			if err := g.regex(sb, sub, maxRepeat); err != nil {
+				return err
+			}
+		}
+	case syntax.OpAlternate:
+		return g.regex(sb, re.Sub[g.rng.IntN(len(re.Sub))], maxRepeat)
+	default:
+		return fmt.Errorf("unsupported regular expression operator: %s", re.Op)
+	}
+	return nil
+}
+
+// repeat emits between lo and hi occurrences of re, inclusive
+func (g *Generator) repeat(sb *strings.Builder, re *syntax.Regexp, lo, hi, maxRepeat int) error {
+	n := lo + g.rng.IntN(hi-lo+1)
+	for i := 0; i < n; i++ {
+		if err := g.regex(sb, re, maxRepeat); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// pickRange picks a rune uniformly from a list of inclusive [lo, hi] pairs
+func (g *Generator) pickRange(ranges []rune) rune {
+	total := 0
+	for i := 0; i < len(ranges); i += 2 {
+		total += int(ranges[i+1]-ranges[i]) + 1
+	}
+
+	n := g.rng.IntN(total)
+	for i := 0; i < len(ranges); i += 2 {
+		size := int(ranges[i+1]-ranges[i]) + 1
+		if n < size {
+			return ranges[i] + rune(n)
+		}
+		n -= size
+	}
+	return ranges[0]
+}
+
+// narrow restricts a class to printable ASCII when the two overlap, so negated
+// classes like [^a-z] yield readable characters instead of arbitrary code points
+func narrow(ranges []rune) []rune {
+	var out []rune
+	for i := 0; i < len(ranges); i += 2 {
+		lo, hi := max(ranges[i], printable[0]), min(ranges[i+1], printable[1])
+		if lo <= hi {
+			out = append(out, lo, hi)
+		}
+	}
+	if len(out) == 0 {
+		return ranges
+	}
+	return out
+}
@@ -0,0 +1,58 @@
+package randomstring
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/inovacc/toolkit/data/algorithm/random"
+)
+
+func TestFromRegex(t *testing.T) {
+	patterns := []string{
+		`^[A-Z]{3}-\d{4}$`,                // legacy license plate
+		`^[A-Z]{3}\d[A-Z]\d{2}$`,          // Mercosul plate
+		`^SKU-[0-9A-F]{8}(-[a-z]{2,4})?$`, // SKU with optional suffix
+		`^(ORD|INV|RET)_\d{6,}$`,          // order codes, unbounded repetition
+		`^[^0-9\s]+@example\.(com|org)$`,  // negated class
+		`(?i)^hello\s+world$`,             // case folding
+		`^\w+ .$`,                         // any char
+		`^a*b+c?d{2,}$`,
+	}
+
+	g := New(random.NewSeededSource(1))
+	for _, pattern := range patterns {
+		re := regexp.MustCompile(pattern)
+		for i := 0; i < 200; i++ {
+			s, err := g.FromRegex(pattern, 5)
+			if err != nil {
+				t.Fatalf("FromRegex(%q) failed: %v", pattern, err)
+			}
+			if !re.MatchString(s) {
+				t.Fatalf("FromRegex(%q) = %q does not match", pattern, s)
+			}
+		}
+	}
+}
+
+func TestFromRegexErrors(t *testing.T) {
+	if _, err := FromRegex(`[a-`, 5); err == nil {
+		t.Error("expected error for invalid pattern")
+	}
+
+	if _, err := FromRegex(`a+`, -1); err == nil {
+		t.Error("expected error for negative maxRepeat")
+	}
+
+	if _, err := FromRegex(`[^\x00-\x{10FFFF}]`, 3); err == nil {
+		t.Error("expected error for an empty character class")
+	}
+}
+
+func TestFromRegexSeeded(t *testing.T) {
+	a, _ := New(random.NewSeededSource(3)).FromRegex(`[a-z]{5}\d{3}`, 5)
+	b, _ := New(random.NewSeededSource(3)).FromRegex(`[a-z]{5}\d{3}`, 5)
+
+	if a != b {
+		t.Errorf("same seed produced %q and %q", a, b)
+	}
+}
@@ -0,0 +1,203 @@
+package random
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+)
+
+// The helpers in this file default to crypto/rand. Pass WithSeed or WithSource
+// to any of them for reproducible results, e.g. in tests or load-test replays.
+
+// RandomFloat returns a random float64 in [min, max).
+// Returns an error if min >= max or either bound is not finite.
+func RandomFloat(min, max float64, opts ...Option) (float64, error) {
+	return New(NewOptions(opts...).Source).Float(min, max)
+}
+
+// Float returns a random float64 in [min, max).
+func (g *Generator) Float(min, max float64) (float64, error) {
+	if math.IsNaN(min) || math.IsNaN(max) || math.IsInf(min, 0) || math.IsInf(max, 0) {
+		return 0, fmt.Errorf("invalid range: bounds must be finite")
+	}
+	if min >= max {
+		return 0, fmt.Errorf("invalid range: min (%g) must be less than max (%g)", min, max)
+	}
+	v := min + g.rng.Float64()*(max-min)
+	if v >= max { // guard against rounding up to max
+		v = math.Nextafter(max, min)
+	}
+	return v, nil
+}
+
+// Choice returns a uniformly chosen element of items.
+func Choice[T any](items []T, opts ...Option) (T, error) {
+	var zero T
+	if len(items) == 0 {
+		return zero, errors.New("cannot choose from an empty slice")
+	}
+	return items[NewRand(opts...).IntN(len(items))], nil
+}
+
+// Shuffle randomly permutes items in place using the Fisher-Yates algorithm.
+func Shuffle[T any](items []T, opts ...Option) {
+	NewRand(opts...).Shuffle(len(items), func(i, j int) {
+		items[i], items[j] = items[j], items[i]
+	})
+}
+
+// Sample returns k distinct elements of items chosen uniformly, in random order.
+// If k exceeds len(items), all elements are returned shuffled.
+func Sample[T any](items []T, k int, opts ...Option) ([]T, error) {
+	if k < 0 {
+		return nil, fmt.Errorf("invalid sample size: %d", k)
+	}
+	r := NewReservoir[T](k, opts...)
+	for _, item := range items {
+		r.Add(item)
+	}
+	out := r.Items()
+	Shuffle(out, WithSource(r.src))
+	return out, nil
+}
+
+// Reservoir keeps a uniform random sample of at most k items from a stream of
+// unknown length using reservoir sampling (Algorithm R). Memory use is O(k).
+// It is not safe for concurrent use.
+type Reservoir[T any] struct {
+	src   Source
+	rng   *rand.Rand
+	k     int
+	seen  int
+	items []T
+}
+
+// NewReservoir creates a Reservoir holding at most k items.
+func NewReservoir[T any](k int, opts ...Option) *Reservoir[T] {
+	if k < 0 {
+		k = 0
+	}
+	src := NewOptions(opts...).Source
+	return &Reservoir[T]{
+		src:   src,
+		rng:   rand.New(src),
+		k:     k,
+		items: make([]T, 0, k),
+	}
+}
+
+// Add offers item to the sample.
+func (r *Reservoir[T]) Add(item T) {
+	r.seen++
+	if len(r.items) < r.k {
+		r.items = append(r.items, item)
+		return
+	}
+	if j := r.rng.IntN(r.seen); j < r.k {
+		r.items[j] = item
+	}
+}
+
+// Seen returns how many items have been offered.
+func (r *Reservoir[T]) Seen() int {
+	return r.seen
+}
+
+// Items returns a copy of the current sample.
+func (r *Reservoir[T]) Items() []T {
+	out := make([]T, len(r.items))
+	copy(out, r.items)
+	return out
+}
+
+// Weighted picks items with probability proportional to their weights in O(1)
+// per pick, using Vose's alias method. Building it costs O(n).
+// It is not safe for concurrent use unless its Source is.
+type Weighted[T any] struct {
+	items []T
+	prob  []float64
+	alias []int
+	rng   *rand.Rand
+}
+
+// NewWeighted prepares a weighted picker. Weights must be non-negative, finite
+// and not all zero, and there must be exactly one weight per item.
+func NewWeighted[T any](items []T, weights []float64, opts ...Option) (*Weighted[T], error) {
+	n := len(items)
+	if n == 0 {
+		return nil, errors.New("cannot choose from an empty slice")
+	}
+	if len(weights) != n {
+		return nil, fmt.Errorf("got %d weights for %d items", len(weights), n)
+	}
+
+	total := 0.0
+	for i, w := range weights {
+		if w < 0 || math.IsNaN(w) || math.IsInf(w, 0) {
+			return nil, fmt.Errorf("invalid weight %g at index %d", w, i)
+		}
+		total += w
+	}
+	if total == 0 {
+		return nil, errors.New("weights must not all be zero")
+	}
+
+	w := &Weighted[T]{
+		items: append([]T(nil), items...),
+		prob:  make([]float64, n),
+		alias: make([]int, n),
+		rng:   NewRand(opts...),
+	}
+
+	scaled := make([]float64, n)
+	var small, large []int
+	for i, weight := range weights {
+		scaled[i] = weight * float64(n) / total
+		if scaled[i] < 1 {
+			small = append(small, i)
+		} else {
+			large = append(large, i)
+		}
+	}
+
+	for len(small) > 0 && len(large) > 0 {
+		s, l := small[len(small)-1], large[len(large)-1]
+		small, large = small[:len(small)-1], large[:len(large)-1]
+
+		w.prob[s] = scaled[s]
+		w.alias[s] = l
+
+		scaled[l] = scaled[l] + scaled[s] - 1
+		if scaled[l] < 1 {
+			small = append(small, l)
+		} else {
+			large = append(large, l)
+		}
+	}
+	// Whatever is left is 1 up to floating point error
+	for _, i := range append(small, large...) {
+		w.prob[i] = 1
+	}
+	return w, nil
+}
+
+// Pick returns an item chosen with probability proportional to its weight.
+func (w *Weighted[T]) Pick() T {
+	i := w.rng.IntN(len(w.items))
+	if w.rng.Float64() < w.prob[i] {
+		return w.items[i]
+	}
+	return w.items[w.alias[i]]
+}
+
+// WeightedChoice returns one item chosen with probability proportional to its weight.
+// Use NewWeighted when picking repeatedly from the same items.
+func WeightedChoice[T any](items []T, weights []float64, opts ...Option) (T, error) {
+	w, err := NewWeighted(items, weights, opts...)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return w.Pick(), nil
+}
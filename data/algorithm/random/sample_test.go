@@ -0,0 +1,138 @@
+package random
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestRandomFloat(t *testing.T) {
+	for i := 0; i < 1000; i++ {
+		v, err := RandomFloat(-2.5, 2.5)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v < -2.5 || v >= 2.5 {
+			t.Fatalf("RandomFloat out of range: %g", v)
+		}
+	}
+
+	if _, err := RandomFloat(1, 1); err == nil {
+		t.Error("expected error for empty range")
+	}
+
+	a, _ := RandomFloat(0, 1, WithSeed(3))
+	b, _ := RandomFloat(0, 1, WithSeed(3))
+	if a != b {
+		t.Error("seeded RandomFloat is not reproducible")
+	}
+}
+
+func TestChoice(t *testing.T) {
+	items := []string{"a", "b", "c"}
+
+	v, err := Choice(items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != "a" && v != "b" && v != "c" {
+		t.Errorf("Choice returned %q", v)
+	}
+
+	if _, err := Choice([]int{}); err == nil {
+		t.Error("expected error for empty slice")
+	}
+}
+
+func TestShuffle(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	a := append([]int(nil), items...)
+	b := append([]int(nil), items...)
+
+	Shuffle(a, WithSeed(9))
+	Shuffle(b, WithSeed(9))
+	if !reflect.DeepEqual(a, b) {
+		t.Error("seeded Shuffle is not reproducible")
+	}
+
+	sort.Ints(a)
+	if !reflect.DeepEqual(a, items) {
+		t.Error("Shuffle lost or duplicated elements")
+	}
+}
+
+func TestSample(t *testing.T) {
+	items := make([]int, 100)
+	for i := range items {
+		items[i] = i
+	}
+
+	got, err := Sample(items, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 10 {
+		t.Fatalf("Sample returned %d items, want 10", len(got))
+	}
+
+	seen := map[int]bool{}
+	for _, v := range got {
+		if seen[v] {
+			t.Fatalf("Sample returned duplicate %d", v)
+		}
+		seen[v] = true
+	}
+
+	all, _ := Sample(items[:5], 10)
+	if len(all) != 5 {
+		t.Errorf("oversized Sample returned %d items, want 5", len(all))
+	}
+}
+
+func TestReservoirUniform(t *testing.T) {
+	counts := make([]int, 10)
+	src := NewSeededSource(1)
+	for run := 0; run < 10000; run++ {
+		r := NewReservoir[int](1, WithSource(src))
+		for i := 0; i < 10; i++ {
+			r.Add(i)
+		}
+		counts[r.Items()[0]]++
+	}
+
+	for i, c := range counts {
+		if c < 850 || c > 1150 {
+			t.Errorf("item %d sampled %d times, want about 1000", i, c)
+		}
+	}
+}
+
+func TestWeighted(t *testing.T) {
+	w, err := NewWeighted([]string{"a", "b", "c"}, []float64{1, 3, 0}, WithSeed(5))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	counts := map[string]int{}
+	for i := 0; i < 20000; i++ {
+		counts[w.Pick()]++
+	}
+
+	if counts["c"] != 0 {
+		t.Errorf("zero-weight item picked %d times", counts["c"])
+	}
+	ratio := float64(counts["b"]) / float64(counts["a"])
+	if ratio < 2.7 || ratio > 3.3 {
+		t.Errorf("weight ratio = %.2f, want about 3 (%v)", ratio, counts)
+	}
+
+	if _, err := NewWeighted([]int{1}, []float64{1, 2}); err == nil {
+		t.Error("expected error for mismatched weights")
+	}
+	if _, err := NewWeighted([]int{1, 2}, []float64{0, 0}); err == nil {
+		t.Error("expected error for all-zero weights")
+	}
+	if _, err := WeightedChoice([]int{1, 2}, []float64{-1, 2}); err == nil {
+		t.Error("expected error for negative weight")
+	}
+}
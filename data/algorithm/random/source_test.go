@@ -0,0 +1,58 @@
+package random
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestSeededGeneratorIsReproducible(t *testing.T) {
+	a, b := NewSeeded(42), NewSeeded(42)
+
+	if a.String(32) != b.String(32) {
+		t.Error("same seed produced different strings")
+	}
+
+	x, _ := a.Int(0, 1_000_000)
+	y, _ := b.Int(0, 1_000_000)
+	if x != y {
+		t.Errorf("same seed produced different ints: %d != %d", x, y)
+	}
+
+	bx, _ := a.Bytes(13)
+	by, _ := b.Bytes(13)
+	if !bytes.Equal(bx, by) {
+		t.Error("same seed produced different bytes")
+	}
+
+	if NewSeeded(1).String(32) == NewSeeded(2).String(32) {
+		t.Error("different seeds produced the same string")
+	}
+}
+
+func TestNewRandOptions(t *testing.T) {
+	if NewRand(WithSeed(7)).Uint64() != NewRand(WithSeed(7)).Uint64() {
+		t.Error("WithSeed is not reproducible")
+	}
+
+	shared := NewSeededSource(7)
+	if NewRand(WithSource(shared)).Uint64() == NewRand(WithSource(shared)).Uint64() {
+		t.Error("a shared source should advance between calls")
+	}
+
+	if NewRand().Uint64() == NewRand(WithCrypto()).Uint64() {
+		t.Error("crypto source returned identical values")
+	}
+}
+
+func TestCryptoGeneratorBytes(t *testing.T) {
+	g := NewCrypto()
+
+	b, err := g.Bytes(32)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(b) != 32 || bytes.Equal(b, make([]byte, 32)) {
+		t.Errorf("unexpected crypto bytes: %x", b)
+	}
+}
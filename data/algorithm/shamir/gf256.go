@@ -0,0 +1,41 @@
+package shamir
+
+// Arithmetic in GF(2⁸) modulo the Rijndael polynomial x⁸ + x⁴ + x³ + x + 1,
+// the field used by AES and SLIP-39. Addition is XOR; multiplication and
+// division go through log and exp tables built on the generator 3.
+
+var (
+	expTable [255]byte
+	logTable [256]byte
+)
+
+func init() {
+	x := byte(1)
+	for i := range expTable {
+		expTable[i] = x
+		logTable[x] = byte(i)
+
+		// x *= 3, i.e. x ^ 2x with the reduction of 2x
+		hi := x & 0x80
+		x2 := x << 1
+		if hi != 0 {
+			x2 ^= 0x1b
+		}
+		x ^= x2
+	}
+}
+
+func mul(a, b byte) byte {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return expTable[(int(logTable[a])+int(logTable[b]))%255]
+}
+
+// div returns a / b; b must not be zero.
+func div(a, b byte) byte {
+	if a == 0 {
+		return 0
+	}
+	return expTable[(int(logTable[a])-int(logTable[b])+255)%255]
+}
@@ -0,0 +1,132 @@
+// Package shamir implements Shamir's secret sharing over GF(256).
+//
+// Split cuts a secret into n shares so that any threshold of them rebuild it
+// with Combine, while fewer reveal nothing about it. Every byte of the secret
+// is shared independently with its own random polynomial.
+package shamir
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/inovacc/toolkit/data/algorithm/random"
+)
+
+var (
+	ErrThreshold      = errors.New("shamir: threshold must be between 1 and the number of shares")
+	ErrShareCount     = errors.New("shamir: share count must be between 1 and 255")
+	ErrEmptySecret    = errors.New("shamir: secret is empty")
+	ErrNoShares       = errors.New("shamir: no shares given")
+	ErrShareLength    = errors.New("shamir: shares have different lengths")
+	ErrDuplicateShare = errors.New("shamir: duplicate share index")
+)
+
+// Share is one point of the sharing polynomials: the x coordinate and the
+// value of every byte's polynomial at x.
+type Share struct {
+	X     byte
+	Value []byte
+}
+
+// Bytes encodes the share as its value followed by the x coordinate.
+func (s Share) Bytes() []byte {
+	return append(append([]byte(nil), s.Value...), s.X)
+}
+
+// ParseShare decodes a share produced by Share.Bytes.
+func ParseShare(b []byte) (Share, error) {
+	if len(b) < 2 {
+		return Share{}, fmt.Errorf("shamir: share too short: %d bytes", len(b))
+	}
+	return Share{X: b[len(b)-1], Value: append([]byte(nil), b[:len(b)-1]...)}, nil
+}
+
+// Split divides secret into n shares, any threshold of which recover it.
+// Shares use x = 1..n. It defaults to crypto/rand for the polynomial
+// coefficients; only pass a seeded source in tests.
+func Split(secret []byte, n, threshold int, opts ...random.Option) ([]Share, error) {
+	switch {
+	case len(secret) == 0:
+		return nil, ErrEmptySecret
+	case n < 1 || n > 255:
+		return nil, ErrShareCount
+	case threshold < 1 || threshold > n:
+		return nil, ErrThreshold
+	}
+
+	// coefficients[k] holds the degree k+1 coefficient of every byte's polynomial
+	gen := random.New(random.NewOptions(opts...).Source)
+	coefficients := make([][]byte, threshold-1)
+	for k := range coefficients {
+		c, err := gen.Bytes(uint32(len(secret)))
+		if err != nil {
+			return nil, err
+		}
+		coefficients[k] = c
+	}
+
+	shares := make([]Share, n)
+	for i := range shares {
+		x := byte(i + 1)
+		value := make([]byte, len(secret))
+		for j := range secret {
+			// Horner's rule, highest degree first
+			var y byte
+			for k := len(coefficients) - 1; k >= 0; k-- {
+				y = mul(y, x) ^ coefficients[k][j]
+			}
+			value[j] = mul(y, x) ^ secret[j]
+		}
+		shares[i] = Share{X: x, Value: value}
+	}
+	return shares, nil
+}
+
+// Combine rebuilds the secret from at least threshold shares. With fewer
+// shares it returns a wrong secret rather than an error, since the shares
+// carry no integrity check.
+func Combine(shares []Share) ([]byte, error) {
+	return Interpolate(shares, 0)
+}
+
+// Interpolate evaluates at x the polynomials passing through shares, using
+// Lagrange interpolation. Combine is Interpolate at 0; schemes such as SLIP-39
+// store the secret and a digest at other coordinates.
+func Interpolate(shares []Share, x byte) ([]byte, error) {
+	if len(shares) == 0 {
+		return nil, ErrNoShares
+	}
+
+	size := len(shares[0].Value)
+	seen := make(map[byte]bool, len(shares))
+	for _, s := range shares {
+		if len(s.Value) != size {
+			return nil, ErrShareLength
+		}
+		if seen[s.X] {
+			return nil, ErrDuplicateShare
+		}
+		seen[s.X] = true
+	}
+
+	for _, s := range shares {
+		if s.X == x {
+			return append([]byte(nil), s.Value...), nil
+		}
+	}
+
+	out := make([]byte, size)
+	for i, si := range shares {
+		// Lagrange basis polynomial of share i at x
+		basis := byte(1)
+		for j, sj := range shares {
+			if i != j {
+				basis = mul(basis, div(x^sj.X, si.X^sj.X))
+			}
+		}
+		for k := range out {
+			out[k] ^= mul(basis, si.Value[k])
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,115 @@
+package shamir
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/inovacc/toolkit/data/algorithm/random"
+)
+
+func TestField(t *testing.T) {
+	// 0x53 and 0xca are inverses in the AES field (FIPS-197, section 4.2)
+	if got := mul(0x53, 0xca); got != 0x01 {
+		t.Errorf("mul(0x53, 0xca) = %#x; want 0x01", got)
+	}
+	if got := mul(0x57, 0x83); got != 0xc1 {
+		t.Errorf("mul(0x57, 0x83) = %#x; want 0xc1", got)
+	}
+
+	for a := 1; a < 256; a++ {
+		for b := 1; b < 256; b++ {
+			if div(mul(byte(a), byte(b)), byte(b)) != byte(a) {
+				t.Fatalf("div(mul(%d, %d), %d) != %d", a, b, b, a)
+			}
+		}
+	}
+}
+
+func TestSplitCombine(t *testing.T) {
+	secret := []byte("correct horse battery staple")
+
+	shares, err := Split(secret, 5, 3)
+	if err != nil {
+		t.Fatalf("Split() error: %v", err)
+	}
+
+	// Every 3-subset recovers the secret
+	for i := 0; i < 5; i++ {
+		for j := i + 1; j < 5; j++ {
+			for k := j + 1; k < 5; k++ {
+				got, err := Combine([]Share{shares[i], shares[j], shares[k]})
+				if err != nil {
+					t.Fatalf("Combine() error: %v", err)
+				}
+				if !bytes.Equal(got, secret) {
+					t.Errorf("Combine(%d, %d, %d) = %q", i, j, k, got)
+				}
+			}
+		}
+	}
+
+	// Two shares are not enough
+	if got, _ := Combine(shares[:2]); bytes.Equal(got, secret) {
+		t.Error("Combine() recovered the secret below the threshold")
+	}
+}
+
+func TestSplitThresholdOne(t *testing.T) {
+	shares, _ := Split([]byte{1, 2, 3}, 3, 1)
+	for _, s := range shares {
+		if !bytes.Equal(s.Value, []byte{1, 2, 3}) {
+			t.Errorf("share %d = %v; want the secret", s.X, s.Value)
+		}
+	}
+}
+
+func TestSplitSeeded(t *testing.T) {
+	a, _ := Split([]byte("secret"), 3, 2, random.WithSeed(9))
+	b, _ := Split([]byte("secret"), 3, 2, random.WithSeed(9))
+	for i := range a {
+		if !bytes.Equal(a[i].Value, b[i].Value) {
+			t.Fatalf("same seed produced different shares")
+		}
+	}
+}
+
+func TestShareBytes(t *testing.T) {
+	shares, _ := Split([]byte("secret"), 3, 2)
+	for _, s := range shares {
+		parsed, err := ParseShare(s.Bytes())
+		if err != nil || parsed.X != s.X || !bytes.Equal(parsed.Value, s.Value) {
+			t.Errorf("ParseShare() = %v, %v; want %v", parsed, err, s)
+		}
+	}
+}
+
+func TestErrors(t *testing.T) {
+	tests := []struct {
+		secret       []byte
+		n, threshold int
+		want         error
+	}{
+		{nil, 3, 2, ErrEmptySecret},
+		{[]byte("x"), 0, 1, ErrShareCount},
+		{[]byte("x"), 256, 2, ErrShareCount},
+		{[]byte("x"), 3, 4, ErrThreshold},
+		{[]byte("x"), 3, 0, ErrThreshold},
+	}
+	for _, tt := range tests {
+		if _, err := Split(tt.secret, tt.n, tt.threshold); !errors.Is(err, tt.want) {
+			t.Errorf("Split(%d, %d) = %v; want %v", tt.n, tt.threshold, err, tt.want)
+		}
+	}
+
+	shares, _ := Split([]byte("secret"), 3, 2)
+	if _, err := Combine([]Share{shares[0], shares[0]}); !errors.Is(err, ErrDuplicateShare) {
+		t.Errorf("expected ErrDuplicateShare, got %v", err)
+	}
+	if _, err := Combine([]Share{shares[0], {X: 9, Value: []byte{1}}}); !errors.Is(err, ErrShareLength) {
+		t.Errorf("expected ErrShareLength, got %v", err)
+	}
+	if _, err := Combine(nil); !errors.Is(err, ErrNoShares) {
+		t.Errorf("expected ErrNoShares, got %v", err)
+	}
+}
@@ -1,7 +1,7 @@
 package card
 
 import (
-	"math/rand"
+	"math/rand/v2"
 	"strconv"
 	"strings"
 )
@@ -68,19 +68,21 @@ var debitCardSpecs = map[string][]Details{
 	},
 }
 
-func generateNumber(prefix string, length int) string {
+func generateNumber(rng *rand.Rand, prefix string, length int) string {
 	remainingLength := length - len(prefix) - 1
 	number := prefix
 	for i := 0; i < remainingLength; i++ {
-		number += strconv.Itoa(rand.Intn(10))
+		number += strconv.Itoa(rng.IntN(10))
 	}
-	checkDigit := generateLuhnCheckDigit(number)
+	checkDigit := LuhnCheckDigit(number)
 	return number + strconv.Itoa(checkDigit)
 }
 
-func generateLuhnCheckDigit(partial string) int {
+// LuhnCheckDigit returns the Luhn (mod 10) check digit to append to a partial card number.
+func LuhnCheckDigit(partial string) int {
 	sum := 0
-	double := len(partial)%2 == 0
+	// The rightmost digit of the partial number is the first to be doubled
+	double := len(partial)%2 == 1
 
 	for i := 0; i < len(partial); i++ {
 		digit := int(partial[i] - '0')
@@ -97,42 +99,57 @@ func generateLuhnCheckDigit(partial string) int {
 	return (10 - (sum % 10)) % 10
 }
 
-func generateCVV(length int) string {
+func generateCVV(rng *rand.Rand, length int) string {
 	var cvv strings.Builder
 	for i := 0; i < length; i++ {
-		cvv.WriteString(strconv.Itoa(rand.Intn(10)))
+		cvv.WriteString(strconv.Itoa(rng.IntN(10)))
 	}
 	return cvv.String()
 }
 
-func formatNumber(number string) string {
+// LuhnValid reports whether number, including its final check digit, passes the Luhn check.
+func LuhnValid(number string) bool {
+	if len(number) < 2 {
+		return false
+	}
+	for i := 0; i < len(number); i++ {
+		if number[i] < '0' || number[i] > '9' {
+			return false
+		}
+	}
+	return LuhnCheckDigit(number[:len(number)-1]) == int(number[len(number)-1]-'0')
+}
+
+// FormatNumber groups the digits of a card number the way they are embossed:
+// 4-6-5 for American Express, 4-6-4 for 14-digit Diners Club and groups of four otherwise.
+func FormatNumber(number string) string {
+	number = normalizeNumber(number)
+
+	groups := []int{4}
+	switch {
+	case len(number) == 15 && DetectBrand(number) == AmericanExpress:
+		groups = []int{4, 6, 5}
+	case len(number) == 14 && DetectBrand(number) == DinersClub:
+		groups = []int{4, 6, 4}
+	}
+
 	var parts []string
-	for i := 0; i < len(number); i += 4 {
-		end := i + 4
+	for i, g := 0, 0; i < len(number); g++ {
+		size := groups[len(groups)-1]
+		if g < len(groups) {
+			size = groups[g]
+		}
+		end := i + size
 		if end > len(number) {
 			end = len(number)
 		}
 		parts = append(parts, number[i:end])
+		i = end
 	}
 	return strings.Join(parts, " ")
 }
 
-func cardBrand(number string) string {
-	number = strings.ReplaceAll(number, " ", "")
-	switch {
-	case strings.HasPrefix(number, "4"):
-		return "Visa"
-	case strings.HasPrefix(number, "34"), strings.HasPrefix(number, "37"):
-		return "American Express"
-	case strings.HasPrefix(number, "5"):
-		return "Mastercard"
-	case strings.HasPrefix(number, "6011"), strings.HasPrefix(number, "65"):
-		return "Discover"
-	case strings.HasPrefix(number, "35"):
-		return "JCB"
-	case strings.HasPrefix(number, "30"), strings.HasPrefix(number, "36"), strings.HasPrefix(number, "38"):
-		return "Diners Club"
-	default:
-		return "Unknown"
-	}
+// normalizeNumber strips the spaces and dashes commonly used to group card digits.
+func normalizeNumber(number string) string {
+	return strings.NewReplacer(" ", "", "-", "").Replace(strings.TrimSpace(number))
 }
@@ -2,38 +2,43 @@ package card
 
 import (
 	"fmt"
-	"math/rand"
 	"strings"
 	"time"
 
 	"github.com/brianvoe/gofakeit/v7"
+	"github.com/inovacc/toolkit/data/algorithm/random"
 )
 
-func GenerateCreditCard(actual bool) Card {
+// GenerateCreditCard generates a random credit card. When actual is false the issue date is random.
+// By default it uses crypto/rand; pass random.WithSeed or random.WithSource for reproducible output.
+func GenerateCreditCard(actual bool, opts ...random.Option) Card {
+	src := random.NewOptions(opts...).Source
+	rng := random.NewRand(random.WithSource(src))
+	faker := gofakeit.NewFaker(src, true)
+
 	now := time.Now()
 	if !actual {
-		now = gofakeit.Date()
+		now = faker.Date()
 	}
 	brands := []string{"Visa", "Mastercard", "American Express", "Discover"}
-	brand := brands[rand.Intn(len(brands))]
+	brand := brands[rng.IntN(len(brands))]
 
 	specs := creditCardSpecs[brand]
-	spec := specs[rand.Intn(len(specs))]
+	spec := specs[rng.IntN(len(specs))]
 
-	number := generateNumber(spec.Prefix, spec.Length)
-	expiryYear := now.Year() + rand.Intn(3) + 3
-	expiryMonth := rand.Intn(12) + 1
+	number := generateNumber(rng, spec.Prefix, spec.Length)
+	expiryMonth, expiryYear := futureExpiry(rng, now, 3)
 
 	cvvLength := 3
 	if brand == "American Express" {
 		cvvLength = 4
 	}
-	cvv := generateCVV(cvvLength)
+	cvv := generateCVV(rng, cvvLength)
 
-	person := gofakeit.Person()
+	person := faker.Person()
 
 	return Card{
-		Number:         formatNumber(number),
+		Number:         FormatNumber(number),
 		CardholderName: strings.ToUpper(fmt.Sprintf("%s %s", person.FirstName, person.LastName)),
 		ExpiryMonth:    expiryMonth,
 		ExpiryYear:     expiryYear,
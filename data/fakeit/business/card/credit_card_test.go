@@ -3,6 +3,8 @@ package card
 import (
 	"fmt"
 	"testing"
+
+	"github.com/inovacc/toolkit/data/algorithm/random"
 )
 
 func TestGenerateCreditCard(t *testing.T) {
@@ -16,3 +18,12 @@ func TestGenerateCreditCard(t *testing.T) {
 	fmt.Printf("CVV: %s\n", creditCard.CVV)
 	fmt.Printf("Issue Date: %v\n\n", creditCard.IssueDate)
 }
+
+func TestGenerateCreditCardSeeded(t *testing.T) {
+	a := GenerateCreditCard(false, random.WithSeed(7))
+	b := GenerateCreditCard(false, random.WithSeed(7))
+
+	if a != b {
+		t.Errorf("same seed produced different cards:\n%+v\n%+v", a, b)
+	}
+}
@@ -2,34 +2,39 @@ package card
 
 import (
 	"fmt"
-	"math/rand"
 	"strings"
 	"time"
 
 	"github.com/brianvoe/gofakeit/v7"
+	"github.com/inovacc/toolkit/data/algorithm/random"
 )
 
-func GenerateDebitCard(actual bool) Card {
+// GenerateDebitCard generates a random debit card. When actual is false the issue date is random.
+// By default it uses crypto/rand; pass random.WithSeed or random.WithSource for reproducible output.
+func GenerateDebitCard(actual bool, opts ...random.Option) Card {
+	src := random.NewOptions(opts...).Source
+	rng := random.NewRand(random.WithSource(src))
+	faker := gofakeit.NewFaker(src, true)
+
 	now := time.Now()
 	if !actual {
-		now = gofakeit.Date()
+		now = faker.Date()
 	}
 	brands := []string{"Visa Electron", "Maestro", "Visa Debit", "Mastercard Debit"}
-	brand := brands[rand.Intn(len(brands))]
+	brand := brands[rng.IntN(len(brands))]
 
 	specs := debitCardSpecs[brand]
-	spec := specs[rand.Intn(len(specs))]
+	spec := specs[rng.IntN(len(specs))]
 
-	number := generateNumber(spec.Prefix, spec.Length)
-	expiryYear := now.Year() + rand.Intn(3) + 2
-	expiryMonth := rand.Intn(12) + 1
+	number := generateNumber(rng, spec.Prefix, spec.Length)
+	expiryMonth, expiryYear := futureExpiry(rng, now, 2)
 
-	cvv := generateCVV(3)
+	cvv := generateCVV(rng, 3)
 
-	person := gofakeit.Person()
+	person := faker.Person()
 
 	return Card{
-		Number:         formatNumber(number),
+		Number:         FormatNumber(number),
 		CardholderName: strings.ToUpper(fmt.Sprintf("%s %s", person.FirstName, person.LastName)),
 		ExpiryMonth:    expiryMonth,
 		ExpiryYear:     expiryYear,
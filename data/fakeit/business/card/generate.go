@@ -0,0 +1,68 @@
+package card
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/inovacc/toolkit/data/algorithm/random"
+)
+
+// GenerateCard generates a card of the requested brand using the IIN range table,
+// so every brand DetectBrand knows, including Elo, Hipercard and UnionPay, can be produced.
+// When actual is false the issue date is random; the expiry is always in the future.
+func GenerateCard(brand string, actual bool, opts ...random.Option) (Card, error) {
+	ranges := Ranges(brand)
+	if len(ranges) == 0 {
+		return Card{}, fmt.Errorf("unknown card brand: %q", brand)
+	}
+
+	src := random.NewOptions(opts...).Source
+	rng := random.NewRand(random.WithSource(src))
+	faker := gofakeit.NewFaker(src, true)
+
+	now := time.Now()
+	if !actual {
+		now = faker.Date()
+	}
+
+	r := ranges[rng.IntN(len(ranges))]
+	prefix := strconv.Itoa(r.Low + rng.IntN(r.High-r.Low+1))
+	length := r.Lengths[rng.IntN(len(r.Lengths))]
+
+	number := generateNumber(rng, prefix, length)
+	// Short prefixes such as Visa's "4" can land in a more specific range owned by
+	// another brand; retry until the number detects as the requested brand
+	for DetectBrand(number) != brand {
+		number = generateNumber(rng, prefix, length)
+	}
+
+	expiryMonth, expiryYear := futureExpiry(rng, now, 3)
+	person := faker.Person()
+
+	return Card{
+		Number:         FormatNumber(number),
+		CardholderName: strings.ToUpper(fmt.Sprintf("%s %s", person.FirstName, person.LastName)),
+		ExpiryMonth:    expiryMonth,
+		ExpiryYear:     expiryYear,
+		CVV:            generateCVV(rng, r.CVV),
+		Brand:          brand,
+		IssueDate:      now.Format("01/2006"),
+	}, nil
+}
+
+// futureExpiry picks an expiry a few years after issued, moving it past the
+// current month when issued lies far in the past.
+func futureExpiry(rng *rand.Rand, issued time.Time, minYears int) (month, year int) {
+	month = rng.IntN(12) + 1
+	year = issued.Year() + minYears + rng.IntN(3)
+
+	now := time.Now()
+	if year < now.Year() || (year == now.Year() && month <= int(now.Month())) {
+		year = now.Year() + 1 + rng.IntN(3)
+	}
+	return month, year
+}
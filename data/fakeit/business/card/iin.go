@@ -0,0 +1,190 @@
+package card
+
+import (
+	"sort"
+	"strconv"
+)
+
+// Card brands recognized by DetectBrand and accepted by GenerateCard.
+const (
+	Visa            = "Visa"
+	VisaElectron    = "Visa Electron"
+	Mastercard      = "Mastercard"
+	AmericanExpress = "American Express"
+	Discover        = "Discover"
+	JCB             = "JCB"
+	DinersClub      = "Diners Club"
+	UnionPay        = "UnionPay"
+	Maestro         = "Maestro"
+	Elo             = "Elo"
+	Hipercard       = "Hipercard"
+	Mir             = "Mir"
+	Unknown         = "Unknown"
+)
+
+// IINRange is a contiguous block of issuer identification numbers (the leading
+// digits of a card number) assigned to a brand. A range with Low 2221, High 2720
+// covers every number starting with 2221 through 2720.
+type IINRange struct {
+	Brand   string
+	Low     int
+	High    int
+	Digits  int
+	Lengths []int
+	CVV     int
+}
+
+func iin(brand string, low, high int, lengths []int) IINRange {
+	cvv := 3
+	if brand == AmericanExpress {
+		cvv = 4
+	}
+	return IINRange{
+		Brand:   brand,
+		Low:     low,
+		High:    high,
+		Digits:  len(strconv.Itoa(low)),
+		Lengths: lengths,
+		CVV:     cvv,
+	}
+}
+
+var (
+	len16    = []int{16}
+	len16_19 = []int{16, 17, 18, 19}
+)
+
+// iinRanges is the brand table. When ranges overlap the longest prefix wins,
+// which is how Elo and Hipercard BINs are told apart from Visa, Discover and Diners Club.
+var iinRanges = []IINRange{
+	iin(Visa, 4, 4, []int{13, 16, 19}),
+	iin(VisaElectron, 4026, 4026, len16),
+	iin(VisaElectron, 417500, 417500, len16),
+	iin(VisaElectron, 4508, 4508, len16),
+	iin(VisaElectron, 4844, 4844, len16),
+	iin(VisaElectron, 4913, 4913, len16),
+	iin(VisaElectron, 4917, 4917, len16),
+
+	iin(Mastercard, 51, 55, len16),
+	iin(Mastercard, 2221, 2720, len16),
+
+	iin(AmericanExpress, 34, 34, []int{15}),
+	iin(AmericanExpress, 37, 37, []int{15}),
+
+	iin(Discover, 6011, 6011, len16_19),
+	iin(Discover, 644, 649, len16_19),
+	iin(Discover, 65, 65, len16_19),
+
+	iin(JCB, 3528, 3589, len16_19),
+
+	iin(DinersClub, 300, 305, []int{14, 16, 17, 18, 19}),
+	iin(DinersClub, 3095, 3095, []int{14, 16, 17, 18, 19}),
+	iin(DinersClub, 36, 36, []int{14, 15, 16, 17, 18, 19}),
+	iin(DinersClub, 38, 39, []int{14, 16, 17, 18, 19}),
+
+	iin(UnionPay, 62, 62, len16_19),
+	iin(UnionPay, 81, 81, len16_19),
+
+	iin(Maestro, 5018, 5018, []int{12, 13, 14, 15, 16, 17, 18, 19}),
+	iin(Maestro, 5020, 5020, []int{12, 13, 14, 15, 16, 17, 18, 19}),
+	iin(Maestro, 5038, 5038, []int{12, 13, 14, 15, 16, 17, 18, 19}),
+	iin(Maestro, 5893, 5893, []int{12, 13, 14, 15, 16, 17, 18, 19}),
+	iin(Maestro, 6304, 6304, []int{12, 13, 14, 15, 16, 17, 18, 19}),
+	iin(Maestro, 6759, 6759, []int{12, 13, 14, 15, 16, 17, 18, 19}),
+	iin(Maestro, 6761, 6763, []int{12, 13, 14, 15, 16, 17, 18, 19}),
+
+	iin(Mir, 2200, 2204, len16_19),
+
+	iin(Elo, 401178, 401179, len16),
+	iin(Elo, 431274, 431274, len16),
+	iin(Elo, 438935, 438935, len16),
+	iin(Elo, 451416, 451416, len16),
+	iin(Elo, 457393, 457393, len16),
+	iin(Elo, 457631, 457632, len16),
+	iin(Elo, 504175, 504175, len16),
+	iin(Elo, 506699, 506778, len16),
+	iin(Elo, 509000, 509999, len16),
+	iin(Elo, 627780, 627780, len16),
+	iin(Elo, 636297, 636297, len16),
+	iin(Elo, 636368, 636368, len16),
+	iin(Elo, 650031, 650033, len16),
+	iin(Elo, 650035, 650051, len16),
+	iin(Elo, 650405, 650439, len16),
+	iin(Elo, 650485, 650538, len16),
+	iin(Elo, 650541, 650598, len16),
+	iin(Elo, 650700, 650718, len16),
+	iin(Elo, 650720, 650727, len16),
+	iin(Elo, 650901, 650978, len16),
+	iin(Elo, 651652, 651679, len16),
+	iin(Elo, 655000, 655019, len16),
+	iin(Elo, 655021, 655058, len16),
+
+	iin(Hipercard, 384100, 384100, []int{16, 19}),
+	iin(Hipercard, 384140, 384140, []int{16, 19}),
+	iin(Hipercard, 384160, 384160, []int{16, 19}),
+	iin(Hipercard, 606282, 606282, []int{16, 19}),
+	iin(Hipercard, 637095, 637095, []int{16, 19}),
+	iin(Hipercard, 637568, 637568, []int{16, 19}),
+	iin(Hipercard, 637599, 637599, []int{16, 19}),
+	iin(Hipercard, 637609, 637609, []int{16, 19}),
+	iin(Hipercard, 637612, 637612, []int{16, 19}),
+}
+
+func init() {
+	// Most specific prefixes first so lookups can stop at the first match
+	sort.SliceStable(iinRanges, func(i, j int) bool {
+		return iinRanges[i].Digits > iinRanges[j].Digits
+	})
+}
+
+// Contains reports whether number starts with a prefix inside the range.
+func (r IINRange) Contains(number string) bool {
+	if len(number) < r.Digits {
+		return false
+	}
+	prefix, err := strconv.Atoi(number[:r.Digits])
+	if err != nil {
+		return false
+	}
+	return prefix >= r.Low && prefix <= r.High
+}
+
+// ValidLength reports whether n is a valid card number length for the range.
+func (r IINRange) ValidLength(n int) bool {
+	for _, l := range r.Lengths {
+		if l == n {
+			return true
+		}
+	}
+	return false
+}
+
+// LookupIIN returns the most specific IIN range matching the leading digits of number.
+func LookupIIN(number string) (IINRange, bool) {
+	number = normalizeNumber(number)
+	for _, r := range iinRanges {
+		if r.Contains(number) {
+			return r, true
+		}
+	}
+	return IINRange{}, false
+}
+
+// Ranges returns the IIN ranges assigned to brand.
+func Ranges(brand string) []IINRange {
+	var out []IINRange
+	for _, r := range iinRanges {
+		if r.Brand == brand {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+// DetectBrand returns the brand of a card number, or Unknown.
+func DetectBrand(number string) string {
+	if r, ok := LookupIIN(number); ok {
+		return r.Brand
+	}
+	return Unknown
+}
@@ -0,0 +1,47 @@
+package card
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Validation is the result of checking a card number.
+type Validation struct {
+	Number      string // digits only
+	Formatted   string
+	Brand       string
+	Luhn        bool
+	LengthValid bool
+}
+
+// Valid reports whether the number belongs to a known brand, has a valid length for it and passes the Luhn check.
+func (v Validation) Valid() bool {
+	return v.Brand != Unknown && v.Luhn && v.LengthValid
+}
+
+// Validate parses a card number, ignoring spaces and dashes, and reports its brand,
+// Luhn result, length check and embossed formatting. An error is returned only
+// for input that is not a card number at all.
+func Validate(number string) (Validation, error) {
+	digits := normalizeNumber(number)
+	if digits == "" {
+		return Validation{}, errors.New("empty card number")
+	}
+	for _, c := range digits {
+		if c < '0' || c > '9' {
+			return Validation{}, fmt.Errorf("invalid character %q in card number", c)
+		}
+	}
+
+	v := Validation{
+		Number:    digits,
+		Formatted: FormatNumber(digits),
+		Brand:     Unknown,
+		Luhn:      LuhnValid(digits),
+	}
+	if r, ok := LookupIIN(digits); ok {
+		v.Brand = r.Brand
+		v.LengthValid = r.ValidLength(len(digits))
+	}
+	return v, nil
+}
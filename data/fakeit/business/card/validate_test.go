@@ -0,0 +1,100 @@
+package card
+
+import (
+	"testing"
+	"time"
+
+	"github.com/inovacc/toolkit/data/algorithm/random"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		number    string
+		brand     string
+		valid     bool
+		formatted string
+	}{
+		{number: "4111 1111 1111 1111", brand: Visa, valid: true, formatted: "4111 1111 1111 1111"},
+		{number: "5555-5555-5555-4444", brand: Mastercard, valid: true, formatted: "5555 5555 5555 4444"},
+		{number: "2223003122003222", brand: Mastercard, valid: true, formatted: "2223 0031 2200 3222"},
+		{number: "378282246310005", brand: AmericanExpress, valid: true, formatted: "3782 822463 10005"},
+		{number: "6011111111111117", brand: Discover, valid: true, formatted: "6011 1111 1111 1117"},
+		{number: "3530111333300000", brand: JCB, valid: true, formatted: "3530 1113 3330 0000"},
+		{number: "30569309025904", brand: DinersClub, valid: true, formatted: "3056 930902 5904"},
+		{number: "6200000000000005", brand: UnionPay, valid: true, formatted: "6200 0000 0000 0005"},
+		{number: "6363680000457017", brand: Elo, valid: true, formatted: "6363 6800 0045 7017"},
+		{number: "6062825624254001", brand: Hipercard, valid: true, formatted: "6062 8256 2425 4001"},
+		{number: "4111111111111112", brand: Visa, valid: false, formatted: "4111 1111 1111 1112"},
+		{number: "9999999999999995", brand: Unknown, valid: false, formatted: "9999 9999 9999 9995"},
+	}
+
+	for _, tt := range tests {
+		v, err := Validate(tt.number)
+		if err != nil {
+			t.Errorf("Validate(%q) failed: %v", tt.number, err)
+			continue
+		}
+		if v.Brand != tt.brand || v.Valid() != tt.valid || v.Formatted != tt.formatted {
+			t.Errorf("Validate(%q) = %+v (valid %t), want brand %s valid %t formatted %q",
+				tt.number, v, v.Valid(), tt.brand, tt.valid, tt.formatted)
+		}
+	}
+
+	if _, err := Validate("4111-abcd"); err == nil {
+		t.Error("expected error for non-digit input")
+	}
+	if _, err := Validate("  "); err == nil {
+		t.Error("expected error for empty input")
+	}
+}
+
+func TestGenerateCard(t *testing.T) {
+	brands := []string{Visa, VisaElectron, Mastercard, AmericanExpress, Discover, JCB, DinersClub,
+		UnionPay, Maestro, Elo, Hipercard, Mir}
+
+	now := time.Now()
+	for _, brand := range brands {
+		for i := 0; i < 20; i++ {
+			c, err := GenerateCard(brand, false)
+			if err != nil {
+				t.Fatalf("GenerateCard(%s) failed: %v", brand, err)
+			}
+
+			v, err := Validate(c.Number)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !v.Valid() || v.Brand != brand {
+				t.Fatalf("GenerateCard(%s) produced %s: %+v", brand, c.Number, v)
+			}
+
+			if c.ExpiryYear < now.Year() || (c.ExpiryYear == now.Year() && c.ExpiryMonth <= int(now.Month())) {
+				t.Fatalf("GenerateCard(%s) produced expired card %02d/%d", brand, c.ExpiryMonth, c.ExpiryYear)
+			}
+		}
+	}
+
+	if _, err := GenerateCard("Dinheiro", true); err == nil {
+		t.Error("expected error for unknown brand")
+	}
+
+	a, _ := GenerateCard(Elo, true, random.WithSeed(1))
+	b, _ := GenerateCard(Elo, true, random.WithSeed(1))
+	if a != b {
+		t.Error("same seed produced different cards")
+	}
+}
+
+func TestGeneratedCardsNeverExpired(t *testing.T) {
+	now := time.Now()
+	for i := 0; i < 200; i++ {
+		for _, c := range []Card{GenerateCreditCard(false), GenerateDebitCard(false)} {
+			if c.ExpiryYear < now.Year() || (c.ExpiryYear == now.Year() && c.ExpiryMonth <= int(now.Month())) {
+				t.Fatalf("generated expired card %02d/%d", c.ExpiryMonth, c.ExpiryYear)
+			}
+			if v, _ := Validate(c.Number); !v.Luhn {
+				t.Fatalf("generated card %s fails Luhn", c.Number)
+			}
+		}
+	}
+}
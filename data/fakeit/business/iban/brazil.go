@@ -0,0 +1,256 @@
+package iban
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+
+	"github.com/inovacc/toolkit/data/algorithm/random"
+)
+
+// Bank is a Brazilian bank with the check digit rules of its branch (agência) and account (conta) numbers.
+type Bank struct {
+	Code string // COMPE code, e.g. "001"
+	ISPB string // 8-digit ISPB, used as the bank identifier in BR IBANs
+	Name string
+
+	agencyLength  int
+	accountLength int
+	// agencyDigit is nil for banks whose branches have no check digit.
+	agencyDigit  func(agency string) string
+	accountDigit func(agency, account string) string
+}
+
+// Account is a Brazilian bank account.
+type Account struct {
+	Bank         Bank
+	Agency       string
+	AgencyDigit  string
+	Account      string
+	AccountDigit string
+}
+
+// FormattedAgency returns the branch with its check digit, e.g. "1584-9".
+func (a Account) FormattedAgency() string {
+	if a.AgencyDigit == "" {
+		return a.Agency
+	}
+	return a.Agency + "-" + a.AgencyDigit
+}
+
+// FormattedAccount returns the account with its check digit, e.g. "00210169-6".
+func (a Account) FormattedAccount() string {
+	return a.Account + "-" + a.AccountDigit
+}
+
+// IBAN returns the BR IBAN of a checking account held by the primary owner.
+// The account field holds the account number and its check digit; letter check digits
+// (X, P) are written as 0, as banks do in numeric-only fields. The Caixa operation
+// code is not part of the IBAN and is dropped.
+func (a Account) IBAN() (string, error) {
+	digit := a.AccountDigit
+	if digit < "0" || digit > "9" {
+		digit = "0"
+	}
+
+	account := a.Account
+	if a.Bank.Code == "104" && len(account) == 11 {
+		account = account[3:]
+	}
+	account += digit
+
+	if len(a.Agency) > 5 || len(account) > 10 {
+		return "", fmt.Errorf("iban: account %s does not fit a BR IBAN", a.FormattedAccount())
+	}
+
+	i, err := New("BR", a.Bank.ISPB+leftPad(a.Agency, 5)+leftPad(account, 10)+"C1")
+	if err != nil {
+		return "", err
+	}
+	return i.String(), nil
+}
+
+// banks lists the supported Brazilian banks.
+var banks = []Bank{
+	{Code: "001", ISPB: "00000000", Name: "Banco do Brasil", agencyLength: 4, accountLength: 8,
+		agencyDigit:  func(ag string) string { return bbDigit(ag, []int{5, 4, 3, 2}) },
+		accountDigit: func(_, acc string) string { return bbDigit(acc, []int{9, 8, 7, 6, 5, 4, 3, 2}) }},
+	{Code: "033", ISPB: "90400888", Name: "Santander", agencyLength: 4, accountLength: 8,
+		accountDigit: santanderDigit},
+	{Code: "104", ISPB: "00360305", Name: "Caixa Econômica Federal", agencyLength: 4, accountLength: 11,
+		accountDigit: caixaDigit},
+	{Code: "237", ISPB: "60746948", Name: "Bradesco", agencyLength: 4, accountLength: 7,
+		agencyDigit:  func(ag string) string { return bradescoDigit(ag, []int{5, 4, 3, 2}) },
+		accountDigit: func(_, acc string) string { return bradescoDigit(acc, []int{2, 7, 6, 5, 4, 3, 2}) }},
+	{Code: "341", ISPB: "60701190", Name: "Itaú Unibanco", agencyLength: 4, accountLength: 5,
+		accountDigit: itauDigit},
+	{Code: "041", ISPB: "92702067", Name: "Banrisul", agencyLength: 4, accountLength: 9,
+		accountDigit: banrisulDigit},
+}
+
+// Banks returns the supported Brazilian banks.
+func Banks() []Bank {
+	return append([]Bank(nil), banks...)
+}
+
+// LookupBank returns a supported Brazilian bank by its COMPE code.
+func LookupBank(code string) (Bank, error) {
+	for _, b := range banks {
+		if b.Code == code {
+			return b, nil
+		}
+	}
+	return Bank{}, fmt.Errorf("iban: unsupported bank %q", code)
+}
+
+// GenerateBankAccount generates a random account with valid check digits at the given bank,
+// or at a random supported bank when code is empty.
+// By default it uses crypto/rand; pass random.WithSeed or random.WithSource for reproducible output.
+func GenerateBankAccount(code string, opts ...random.Option) (Account, error) {
+	rng := random.NewRand(opts...)
+
+	bank := banks[rng.IntN(len(banks))]
+	if code != "" {
+		var err error
+		if bank, err = LookupBank(code); err != nil {
+			return Account{}, err
+		}
+	}
+
+	a := Account{
+		Bank:    bank,
+		Agency:  randomDigits(rng, bank.agencyLength),
+		Account: randomDigits(rng, bank.accountLength),
+	}
+	if bank.agencyDigit != nil {
+		a.AgencyDigit = bank.agencyDigit(a.Agency)
+	}
+	a.AccountDigit = bank.accountDigit(a.Agency, a.Account)
+	return a, nil
+}
+
+// ValidateBankAccount checks the branch and account check digits of a Brazilian account.
+// agency and account are given with their check digits, with or without the dash,
+// e.g. ValidateBankAccount("001", "1584-9", "00210169-6"). For Caixa the account
+// includes the 3-digit operation code.
+func ValidateBankAccount(code, agency, account string) bool {
+	bank, err := LookupBank(code)
+	if err != nil {
+		return false
+	}
+
+	agency = strings.ToUpper(strings.ReplaceAll(agency, "-", ""))
+	account = strings.ToUpper(strings.ReplaceAll(account, "-", ""))
+
+	agencyDigits := bank.agencyLength
+	if bank.agencyDigit != nil {
+		agencyDigits++
+	}
+	if len(agency) != agencyDigits || !isDigits(agency[:bank.agencyLength]) {
+		return false
+	}
+	if len(account) != bank.accountLength+1 || !isDigits(account[:bank.accountLength]) {
+		return false
+	}
+
+	ag := agency[:bank.agencyLength]
+	if bank.agencyDigit != nil && agency[bank.agencyLength:] != bank.agencyDigit(ag) {
+		return false
+	}
+	return account[bank.accountLength:] == bank.accountDigit(ag, account[:bank.accountLength])
+}
+
+// bbDigit is the Banco do Brasil modulo 11 rule: 10 becomes X and 11 becomes 0.
+func bbDigit(digits string, weights []int) string {
+	switch dv := 11 - weightedSum(digits, weights)%11; dv {
+	case 10:
+		return "X"
+	case 11:
+		return "0"
+	default:
+		return strconv.Itoa(dv)
+	}
+}
+
+// bradescoDigit is the Bradesco modulo 11 rule: 10 becomes P and 11 becomes 0.
+func bradescoDigit(digits string, weights []int) string {
+	switch dv := 11 - weightedSum(digits, weights)%11; dv {
+	case 10:
+		return "P"
+	case 11:
+		return "0"
+	default:
+		return strconv.Itoa(dv)
+	}
+}
+
+// itauDigit is a modulo 10 over branch and account, summing the digits of each product.
+func itauDigit(agency, account string) string {
+	sum := 0
+	for i, c := range agency + account {
+		p := int(c-'0') * (2 - i%2)
+		sum += p/10 + p%10
+	}
+	return strconv.Itoa((10 - sum%10) % 10)
+}
+
+// santanderDigit is a modulo 10 over branch, "00" and account, summing the last digit of each product.
+func santanderDigit(agency, account string) string {
+	weights := []int{9, 7, 3, 1, 0, 0, 9, 7, 1, 3, 1, 9, 7, 3}
+	sum := 0
+	for i, c := range agency + "00" + account {
+		sum += int(c-'0') * weights[i] % 10
+	}
+	return strconv.Itoa((10 - sum%10) % 10)
+}
+
+// caixaDigit is a modulo 11 over branch, operation and account, where 10 becomes 0.
+func caixaDigit(agency, account string) string {
+	dv := weightedSum(agency+account, []int{8, 7, 6, 5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}) * 10 % 11
+	if dv == 10 {
+		dv = 0
+	}
+	return strconv.Itoa(dv)
+}
+
+// banrisulDigit is a modulo 11 where a remainder of 1 yields 6.
+func banrisulDigit(_, account string) string {
+	switch r := weightedSum(account, []int{3, 2, 4, 7, 6, 5, 4, 3, 2}) % 11; r {
+	case 0:
+		return "0"
+	case 1:
+		return "6"
+	default:
+		return strconv.Itoa(11 - r)
+	}
+}
+
+func weightedSum(digits string, weights []int) int {
+	sum := 0
+	for i, w := range weights {
+		sum += int(digits[i]-'0') * w
+	}
+	return sum
+}
+
+func randomDigits(rng *rand.Rand, n int) string {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = byte('0' + rng.IntN(10))
+	}
+	return string(b)
+}
+
+func leftPad(s string, n int) string {
+	return strings.Repeat("0", max(0, n-len(s))) + s
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
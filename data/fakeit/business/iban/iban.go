@@ -0,0 +1,158 @@
+// Package iban validates, formats and generates International Bank Account Numbers
+// using the ISO 13616 mod-97 check and a per-country BBAN layout registry.
+package iban
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/inovacc/toolkit/data/algorithm/random"
+)
+
+// IBAN is a parsed International Bank Account Number.
+type IBAN struct {
+	Country     string
+	CheckDigits string
+	BBAN        string
+}
+
+// String returns the IBAN in electronic form, without spaces.
+func (i IBAN) String() string {
+	return i.Country + i.CheckDigits + i.BBAN
+}
+
+// Parse normalizes and validates value, returning its components.
+func Parse(value string) (IBAN, error) {
+	s := Unformat(value)
+	if len(s) < 5 {
+		return IBAN{}, errors.New("iban: too short")
+	}
+
+	st, err := Lookup(s[:2])
+	if err != nil {
+		return IBAN{}, err
+	}
+	if len(s) != st.Length {
+		return IBAN{}, fmt.Errorf("iban: %s IBANs have %d characters, got %d", st.Country, st.Length, len(s))
+	}
+	if !isDigits(s[2:4]) {
+		return IBAN{}, fmt.Errorf("iban: check digits %q are not numeric", s[2:4])
+	}
+	if !st.matches(s[4:]) {
+		return IBAN{}, fmt.Errorf("iban: BBAN does not match the %s layout %s", st.Country, st.BBAN)
+	}
+	if mod97(s[4:]+s[:4]) != 1 {
+		return IBAN{}, errors.New("iban: invalid check digits")
+	}
+
+	return IBAN{Country: s[:2], CheckDigits: s[2:4], BBAN: s[4:]}, nil
+}
+
+// Validate returns nil if value is a valid IBAN, or an error describing the first problem found.
+func Validate(value string) error {
+	_, err := Parse(value)
+	return err
+}
+
+// IsValid reports whether value is a valid IBAN.
+func IsValid(value string) bool {
+	return Validate(value) == nil
+}
+
+// Format groups the IBAN in blocks of four characters, the paper format.
+func Format(value string) string {
+	s := Unformat(value)
+
+	var sb strings.Builder
+	for i := 0; i < len(s); i += 4 {
+		if i > 0 {
+			sb.WriteByte(' ')
+		}
+		sb.WriteString(s[i:min(i+4, len(s))])
+	}
+	return sb.String()
+}
+
+// Unformat removes spaces and dashes and uppercases the IBAN.
+func Unformat(value string) string {
+	return strings.ToUpper(strings.NewReplacer(" ", "", "-", "", "\t", "").Replace(value))
+}
+
+// CheckDigits computes the two check digits for a BBAN in the given country.
+func CheckDigits(country, bban string) string {
+	return fmt.Sprintf("%02d", 98-mod97(strings.ToUpper(bban+country)+"00"))
+}
+
+// New builds an IBAN from a country and BBAN, computing the check digits.
+func New(country, bban string) (IBAN, error) {
+	st, err := Lookup(country)
+	if err != nil {
+		return IBAN{}, err
+	}
+
+	bban = Unformat(bban)
+	if !st.matches(bban) {
+		return IBAN{}, fmt.Errorf("iban: BBAN does not match the %s layout %s", country, st.BBAN)
+	}
+	return IBAN{Country: country, CheckDigits: CheckDigits(country, bban), BBAN: bban}, nil
+}
+
+// Generate returns a random, valid IBAN for the country in electronic form.
+// By default it uses crypto/rand; pass random.WithSeed or random.WithSource for reproducible output.
+func Generate(country string, opts ...random.Option) (string, error) {
+	st, err := Lookup(strings.ToUpper(country))
+	if err != nil {
+		return "", err
+	}
+	if st.Country == "BR" {
+		acc, err := GenerateBankAccount("", opts...)
+		if err != nil {
+			return "", err
+		}
+		return acc.IBAN()
+	}
+
+	rng := random.NewRand(opts...)
+	const (
+		digits = "0123456789"
+		upper  = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	)
+
+	var sb strings.Builder
+	for _, p := range st.parts() {
+		charset := digits
+		switch p.kind {
+		case 'a':
+			charset = upper
+		case 'c':
+			charset = digits + upper
+		}
+		for k := 0; k < p.size; k++ {
+			sb.WriteByte(charset[rng.IntN(len(charset))])
+		}
+	}
+
+	i, err := New(st.Country, sb.String())
+	if err != nil {
+		return "", err
+	}
+	return i.String(), nil
+}
+
+// mod97 computes the remainder of the number formed by s modulo 97, expanding letters to 10-35.
+func mod97(s string) int {
+	r := 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c >= '0' && c <= '9':
+			r = (r*10 + int(c-'0')) % 97
+		case c >= 'A' && c <= 'Z':
+			r = (r*100 + int(c-'A') + 10) % 97
+		default:
+			return -1
+		}
+	}
+	return r
+}
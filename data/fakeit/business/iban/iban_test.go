@@ -0,0 +1,117 @@
+package iban
+
+import (
+	"testing"
+
+	"github.com/inovacc/toolkit/data/algorithm/random"
+)
+
+func TestValidate(t *testing.T) {
+	valid := []string{
+		"DE89370400440532013000",
+		"GB82 WEST 1234 5698 7654 32",
+		"BR18 0036 0305 0000 1000 9795 493C 1",
+		"FR1420041010050500013M02606",
+		"nl91abna0417164300",
+		"ES9121000418450200051332",
+		"PT50000201231234567890154",
+		"NO9386011117947",
+		"IT60X0542811101000000123456",
+	}
+	for _, v := range valid {
+		if err := Validate(v); err != nil {
+			t.Errorf("Validate(%q) = %v", v, err)
+		}
+	}
+
+	invalid := []string{
+		"DE89370400440532013001", // check digits
+		"GBAKWEST12345698765432", // letters passing mod 97
+		"DE8937040044053201300",  // length
+		"XX89370400440532013000", // country
+		"NL91ABNA041716430A",     // layout
+		"DE",
+	}
+	for _, v := range invalid {
+		if IsValid(v) {
+			t.Errorf("Should be invalid IBAN: %s", v)
+		}
+	}
+}
+
+func TestFormat(t *testing.T) {
+	if v := Format("DE89370400440532013000"); v != "DE89 3704 0044 0532 0130 00" {
+		t.Errorf("Incorrectly formatted IBAN: %s", v)
+	}
+
+	if v := Unformat("de89 3704 0044 0532 0130 00"); v != "DE89370400440532013000" {
+		t.Errorf("Incorrectly unformatted IBAN: %s", v)
+	}
+
+	i, err := Parse("GB82WEST12345698765432")
+	if err != nil || i.Country != "GB" || i.CheckDigits != "82" || i.BBAN != "WEST12345698765432" {
+		t.Errorf("Parse = %+v, %v", i, err)
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	for _, country := range Countries() {
+		for i := 0; i < 20; i++ {
+			v, err := Generate(country)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := Validate(v); err != nil || v[:2] != country {
+				t.Fatalf("Generate(%s) = %s: %v", country, v, err)
+			}
+		}
+	}
+
+	if _, err := Generate("XX"); err == nil {
+		t.Error("expected error for unsupported country")
+	}
+
+	a, _ := Generate("DE", random.WithSeed(5))
+	b, _ := Generate("DE", random.WithSeed(5))
+	if a != b {
+		t.Errorf("same seed produced %s and %s", a, b)
+	}
+}
+
+func TestBankAccount(t *testing.T) {
+	cases := []struct{ bank, agency, account string }{
+		{"001", "1584-9", "00210169-6"},
+		{"237", "1425-7", "0238069-2"},
+		{"341", "2545", "02366-1"},
+		{"104", "2004", "00100000448-6"},
+	}
+	for _, c := range cases {
+		if !ValidateBankAccount(c.bank, c.agency, c.account) {
+			t.Errorf("Invalid account %s %s %s", c.bank, c.agency, c.account)
+		}
+	}
+
+	if ValidateBankAccount("001", "1584-9", "00210169-7") || ValidateBankAccount("001", "1584-8", "00210169-6") {
+		t.Error("accepted wrong check digit")
+	}
+	if ValidateBankAccount("999", "1584-9", "00210169-6") {
+		t.Error("accepted unsupported bank")
+	}
+
+	for _, bank := range Banks() {
+		for i := 0; i < 50; i++ {
+			a, err := GenerateBankAccount(bank.Code)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !ValidateBankAccount(bank.Code, a.FormattedAgency(), a.FormattedAccount()) {
+				t.Fatalf("generated invalid account %s %s %s", bank.Code, a.FormattedAgency(), a.FormattedAccount())
+			}
+
+			v, err := a.IBAN()
+			if err != nil || !IsValid(v) || v[4:12] != bank.ISPB {
+				t.Fatalf("IBAN for %s = %s, %v", bank.Name, v, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package iban
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// Structure describes the BBAN layout of a country in SWIFT notation, e.g. "8n10n" for Germany:
+// each part is a length followed by n (digits), a (uppercase letters) or c (alphanumeric).
+type Structure struct {
+	Country string
+	Length  int
+	BBAN    string
+}
+
+// part is one parsed element of a BBAN layout.
+type part struct {
+	size int
+	kind byte
+}
+
+// parts splits the BBAN layout into its elements.
+func (s Structure) parts() []part {
+	var out []part
+	for i := 0; i < len(s.BBAN); {
+		j := i
+		for j < len(s.BBAN) && s.BBAN[j] >= '0' && s.BBAN[j] <= '9' {
+			j++
+		}
+		n, _ := strconv.Atoi(s.BBAN[i:j])
+		out = append(out, part{size: n, kind: s.BBAN[j]})
+		i = j + 1
+	}
+	return out
+}
+
+// matches reports whether bban follows the layout.
+func (s Structure) matches(bban string) bool {
+	if len(bban) != s.Length-4 {
+		return false
+	}
+
+	i := 0
+	for _, p := range s.parts() {
+		for k := 0; k < p.size; k++ {
+			c := bban[i]
+			isDigit := c >= '0' && c <= '9'
+			isUpper := c >= 'A' && c <= 'Z'
+			switch {
+			case p.kind == 'n' && !isDigit,
+				p.kind == 'a' && !isUpper,
+				p.kind == 'c' && !isDigit && !isUpper:
+				return false
+			}
+			i++
+		}
+	}
+	return true
+}
+
+// structures is the registry of IBAN layouts from the SWIFT IBAN registry.
+var structures = map[string]Structure{}
+
+func init() {
+	for _, s := range []Structure{
+		{"AD", 24, "4n4n12c"},
+		{"AE", 23, "3n16n"},
+		{"AT", 20, "5n11n"},
+		{"BE", 16, "3n7n2n"},
+		{"BG", 22, "4a4n2n8c"},
+		{"BR", 29, "8n5n10n1a1c"},
+		{"CH", 21, "5n12c"},
+		{"CR", 22, "4n14n"},
+		{"CY", 28, "3n5n16c"},
+		{"CZ", 24, "4n6n10n"},
+		{"DE", 22, "8n10n"},
+		{"DK", 18, "4n9n1n"},
+		{"DO", 28, "4c20n"},
+		{"EE", 20, "2n2n11n1n"},
+		{"ES", 24, "4n4n1n1n10n"},
+		{"FI", 18, "3n11n"},
+		{"FR", 27, "5n5n11c2n"},
+		{"GB", 22, "4a6n8n"},
+		{"GR", 27, "3n4n16c"},
+		{"GT", 28, "4c20c"},
+		{"HR", 21, "7n10n"},
+		{"HU", 28, "3n4n1n15n1n"},
+		{"IE", 22, "4a6n8n"},
+		{"IL", 23, "3n3n13n"},
+		{"IS", 26, "4n2n6n10n"},
+		{"IT", 27, "1a5n5n12c"},
+		{"KW", 30, "4a22c"},
+		{"LI", 21, "5n12c"},
+		{"LT", 20, "5n11n"},
+		{"LU", 20, "3n13c"},
+		{"LV", 21, "4a13c"},
+		{"MC", 27, "5n5n11c2n"},
+		{"MT", 31, "4a5n18c"},
+		{"NL", 18, "4a10n"},
+		{"NO", 15, "4n6n1n"},
+		{"PK", 24, "4a16c"},
+		{"PL", 28, "8n16n"},
+		{"PT", 25, "4n4n11n2n"},
+		{"QA", 29, "4a21c"},
+		{"RO", 24, "4a16c"},
+		{"SA", 24, "2n18c"},
+		{"SE", 24, "3n16n1n"},
+		{"SI", 19, "5n8n2n"},
+		{"SK", 24, "4n6n10n"},
+		{"SM", 27, "1a5n5n12c"},
+		{"TR", 26, "5n1n16c"},
+	} {
+		structures[s.Country] = s
+	}
+}
+
+// Lookup returns the IBAN layout of a country.
+func Lookup(country string) (Structure, error) {
+	s, ok := structures[country]
+	if !ok {
+		return Structure{}, fmt.Errorf("iban: unsupported country %q", country)
+	}
+	return s, nil
+}
+
+// Countries returns the sorted codes of the countries in the registry.
+func Countries() []string {
+	countries := make([]string, 0, len(structures))
+	for c := range structures {
+		countries = append(countries, c)
+	}
+	sort.Strings(countries)
+	return countries
+}
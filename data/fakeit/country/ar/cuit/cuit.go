@@ -0,0 +1,73 @@
+// Package cuit generates and validates Argentine CUIT/CUIL (Clave Única de Identificación Tributaria/Laboral) numbers.
+package cuit
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/inovacc/toolkit/data/algorithm/random"
+)
+
+var (
+	weights = []int{5, 4, 3, 2, 7, 6, 5, 4, 3, 2}
+	// types lists the valid two-digit prefixes: 20, 23, 24 and 27 for individuals, 30, 33 and 34 for companies.
+	types = []string{"20", "23", "24", "27", "30", "33", "34"}
+)
+
+// GenerateCUIT generates a random, valid CUIT in unformatted form (11 digits).
+// By default it uses crypto/rand; pass random.WithSeed or random.WithSource for reproducible output.
+func GenerateCUIT(opts ...random.Option) string {
+	rng := random.NewRand(opts...)
+
+	for {
+		base := fmt.Sprintf("%s%08d", types[rng.IntN(len(types))], rng.IntN(100000000))
+		// A remainder of 1 has no valid digit; AFIP reassigns such numbers to prefix 23 or 33
+		if dv := calculateDigit(base); dv >= 0 {
+			return fmt.Sprintf("%s%d", base, dv)
+		}
+	}
+}
+
+// ValidateCUIT verifies if a given CUIT/CUIL is syntactically valid.
+func ValidateCUIT(value string) bool {
+	cuit := UnformatCUIT(value)
+	if len(cuit) != 11 || !slices.Contains(types, cuit[:2]) {
+		return false
+	}
+
+	dv := calculateDigit(cuit[:10])
+	return dv >= 0 && cuit[10] == byte('0'+dv)
+}
+
+// FormatCUIT takes a CUIT string (with or without formatting) and returns it in the formatted style: XX-XXXXXXXX-X
+func FormatCUIT(cuit string) string {
+	cuit = UnformatCUIT(cuit)
+	if len(cuit) != 11 {
+		return "Invalid CUIT"
+	}
+	return cuit[:2] + "-" + cuit[2:10] + "-" + cuit[10:]
+}
+
+// UnformatCUIT removes all non-numeric characters from the CUIT string.
+func UnformatCUIT(cuit string) string {
+	re := regexp.MustCompile(`[^0-9]`)
+	return re.ReplaceAllString(cuit, "")
+}
+
+// calculateDigit computes the modulo 11 check digit, returning -1 when the remainder has no valid digit.
+func calculateDigit(base string) int {
+	sum := 0
+	for i, w := range weights {
+		sum += int(base[i]-'0') * w
+	}
+
+	switch dv := 11 - sum%11; dv {
+	case 11:
+		return 0
+	case 10:
+		return -1
+	default:
+		return dv
+	}
+}
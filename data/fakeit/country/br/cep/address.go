@@ -0,0 +1,70 @@
+package cep
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+
+	"github.com/inovacc/toolkit/data/algorithm/random"
+)
+
+// Address is a fake Brazilian postal address whose city, UF and CEP are consistent with each other.
+type Address struct {
+	Logradouro  string
+	Numero      string
+	Complemento string
+	Bairro      string
+	Cidade      string
+	UF          string
+	CEP         string
+}
+
+// String formats the address on a single line, e.g.
+// "Rua Tiradentes, 120, Apto 12 - Centro, Curitiba - PR, 80010-000".
+func (a Address) String() string {
+	var sb strings.Builder
+	sb.WriteString(a.Logradouro + ", " + a.Numero)
+	if a.Complemento != "" {
+		sb.WriteString(", " + a.Complemento)
+	}
+	fmt.Fprintf(&sb, " - %s, %s - %s, %s", a.Bairro, a.Cidade, a.UF, FormatCEP(a.CEP))
+	return sb.String()
+}
+
+// GenerateAddress generates a random address in any UF. The CEP is formatted as #####-###.
+// By default it uses crypto/rand; pass random.WithSeed or random.WithSource for reproducible output.
+func GenerateAddress(opts ...random.Option) Address {
+	rng := random.NewRand(opts...)
+	return newAddress(rng, cities[rng.IntN(len(cities))])
+}
+
+// GenerateAddressForUF generates a random address in a city of the given UF.
+func GenerateAddressForUF(uf string, opts ...random.Option) (Address, error) {
+	uf = strings.ToUpper(strings.TrimSpace(uf))
+
+	var candidates []city
+	for _, c := range cities {
+		if c.uf == uf {
+			candidates = append(candidates, c)
+		}
+	}
+	if len(candidates) == 0 {
+		return Address{}, fmt.Errorf("unknown UF: %q", uf)
+	}
+
+	rng := random.NewRand(opts...)
+	return newAddress(rng, candidates[rng.IntN(len(candidates))]), nil
+}
+
+func newAddress(rng *rand.Rand, c city) Address {
+	return Address{
+		Logradouro:  streetTypes[rng.IntN(len(streetTypes))] + " " + streetNames[rng.IntN(len(streetNames))],
+		Numero:      strconv.Itoa(1 + rng.IntN(3000)),
+		Complemento: complements[rng.IntN(len(complements))],
+		Bairro:      neighborhoods[rng.IntN(len(neighborhoods))],
+		Cidade:      c.name,
+		UF:          c.uf,
+		CEP:         FormatCEP(pickCEP(rng.IntN, c.ranges)),
+	}
+}
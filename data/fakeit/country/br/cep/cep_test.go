@@ -1,6 +1,11 @@
 package cep
 
-import "testing"
+import (
+	"fmt"
+	"testing"
+
+	"github.com/inovacc/toolkit/data/algorithm/random"
+)
 
 func TestIsValidCEP(t *testing.T) {
 	if !IsValidCEP("12345678") {
@@ -28,3 +33,77 @@ func TestIsValidCEP(t *testing.T) {
 		return
 	}
 }
+
+func TestUFFromCEP(t *testing.T) {
+	cases := map[string]string{
+		"01310-100": "SP",
+		"20040-020": "RJ",
+		"70040-010": "DF",
+		"72850-000": "GO",
+		"69301-000": "RR",
+		"69400-000": "AM",
+		"90010-150": "RS",
+	}
+	for cep, want := range cases {
+		if got, err := UFFromCEP(cep); err != nil || got != want {
+			t.Errorf("UFFromCEP(%s) = %s, %v; want %s", cep, got, err, want)
+		}
+	}
+
+	for _, cep := range []string{"00999-999", "78950-000", "1234"} {
+		if ValidateCEP(cep) {
+			t.Errorf("Should be invalid CEP: %s", cep)
+		}
+	}
+}
+
+func TestGenerateCEP(t *testing.T) {
+	for _, uf := range UFs() {
+		for i := 0; i < 20; i++ {
+			cep, err := GenerateCEP(uf)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got, err := UFFromCEP(cep); err != nil || got != uf {
+				t.Fatalf("GenerateCEP(%s) = %s belongs to %s, %v", uf, cep, got, err)
+			}
+		}
+	}
+
+	if len(UFs()) != 27 {
+		t.Errorf("Expected 27 UFs, got %d", len(UFs()))
+	}
+
+	if _, err := GenerateCEP("XX"); err == nil {
+		t.Error("Expected error for unknown UF")
+	}
+}
+
+func TestGenerateAddress(t *testing.T) {
+	for _, c := range cities {
+		for _, r := range c.ranges {
+			if got, _ := UFFromCEP(fmt.Sprintf("%08d", r.Low)); got != c.uf {
+				t.Errorf("%s: range %s is outside %s", c.name, r, c.uf)
+			}
+			if got, _ := UFFromCEP(fmt.Sprintf("%08d", r.High)); got != c.uf {
+				t.Errorf("%s: range %s is outside %s", c.name, r, c.uf)
+			}
+		}
+	}
+
+	for _, uf := range UFs() {
+		a, err := GenerateAddressForUF(uf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, err := UFFromCEP(a.CEP); err != nil || got != uf || a.UF != uf {
+			t.Errorf("inconsistent address: %s", a)
+		}
+	}
+
+	a := GenerateAddress(random.WithSeed(42))
+	b := GenerateAddress(random.WithSeed(42))
+	if a != b {
+		t.Errorf("same seed produced %v and %v", a, b)
+	}
+}
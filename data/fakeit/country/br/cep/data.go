@@ -0,0 +1,76 @@
+package cep
+
+// city is a municipality with the CEP ranges used by its streets.
+type city struct {
+	name   string
+	uf     string
+	ranges []Range
+}
+
+// cities is a representative sample of municipalities, covering every UF.
+// Each range lies inside the UF range of ufRanges.
+var cities = []city{
+	{"São Paulo", "SP", []Range{{"SP", 1000000, 5999999}, {"SP", 8000000, 8499999}}},
+	{"Campinas", "SP", []Range{{"SP", 13000000, 13139999}}},
+	{"Santos", "SP", []Range{{"SP", 11000000, 11099999}}},
+	{"Ribeirão Preto", "SP", []Range{{"SP", 14000000, 14114999}}},
+	{"Rio de Janeiro", "RJ", []Range{{"RJ", 20000000, 23799999}}},
+	{"Niterói", "RJ", []Range{{"RJ", 24000000, 24399999}}},
+	{"Vitória", "ES", []Range{{"ES", 29000000, 29099999}}},
+	{"Vila Velha", "ES", []Range{{"ES", 29100000, 29129999}}},
+	{"Belo Horizonte", "MG", []Range{{"MG", 30000000, 31999999}}},
+	{"Uberlândia", "MG", []Range{{"MG", 38400000, 38415999}}},
+	{"Juiz de Fora", "MG", []Range{{"MG", 36000000, 36099999}}},
+	{"Salvador", "BA", []Range{{"BA", 40000000, 42599999}}},
+	{"Feira de Santana", "BA", []Range{{"BA", 44000000, 44099999}}},
+	{"Aracaju", "SE", []Range{{"SE", 49000000, 49099999}}},
+	{"Recife", "PE", []Range{{"PE", 50000000, 52999999}}},
+	{"Olinda", "PE", []Range{{"PE", 53000000, 53299999}}},
+	{"Maceió", "AL", []Range{{"AL", 57000000, 57099999}}},
+	{"João Pessoa", "PB", []Range{{"PB", 58000000, 58099999}}},
+	{"Campina Grande", "PB", []Range{{"PB", 58400000, 58439999}}},
+	{"Natal", "RN", []Range{{"RN", 59000000, 59139999}}},
+	{"Fortaleza", "CE", []Range{{"CE", 60000000, 61599999}}},
+	{"Teresina", "PI", []Range{{"PI", 64000000, 64099999}}},
+	{"São Luís", "MA", []Range{{"MA", 65000000, 65109999}}},
+	{"Belém", "PA", []Range{{"PA", 66000000, 66999999}}},
+	{"Santarém", "PA", []Range{{"PA", 68005000, 68109999}}},
+	{"Macapá", "AP", []Range{{"AP", 68900000, 68914999}}},
+	{"Manaus", "AM", []Range{{"AM", 69000000, 69099999}}},
+	{"Boa Vista", "RR", []Range{{"RR", 69300000, 69339999}}},
+	{"Rio Branco", "AC", []Range{{"AC", 69900000, 69924999}}},
+	{"Brasília", "DF", []Range{{"DF", 70000000, 70999999}}},
+	{"Taguatinga", "DF", []Range{{"DF", 72000000, 72199999}}},
+	{"Goiânia", "GO", []Range{{"GO", 74000000, 74899999}}},
+	{"Anápolis", "GO", []Range{{"GO", 75000000, 75159999}}},
+	{"Porto Velho", "RO", []Range{{"RO", 76800000, 76834999}}},
+	{"Palmas", "TO", []Range{{"TO", 77000000, 77270999}}},
+	{"Cuiabá", "MT", []Range{{"MT", 78000000, 78109999}}},
+	{"Campo Grande", "MS", []Range{{"MS", 79000000, 79124999}}},
+	{"Curitiba", "PR", []Range{{"PR", 80000000, 82999999}}},
+	{"Londrina", "PR", []Range{{"PR", 86000000, 86099999}}},
+	{"Florianópolis", "SC", []Range{{"SC", 88000000, 88099999}}},
+	{"Joinville", "SC", []Range{{"SC", 89200000, 89239999}}},
+	{"Porto Alegre", "RS", []Range{{"RS", 90000000, 91999999}}},
+	{"Caxias do Sul", "RS", []Range{{"RS", 95000000, 95124999}}},
+}
+
+var streetTypes = []string{"Rua", "Rua", "Rua", "Avenida", "Travessa", "Alameda", "Praça"}
+
+var streetNames = []string{
+	"Sete de Setembro", "XV de Novembro", "Tiradentes", "Dom Pedro II", "das Flores",
+	"Brasil", "Getúlio Vargas", "Santos Dumont", "Marechal Deodoro", "Rui Barbosa",
+	"José Bonifácio", "Presidente Vargas", "da Liberdade", "das Acácias", "dos Andradas",
+	"Barão do Rio Branco", "Princesa Isabel", "Castro Alves", "Duque de Caxias", "Machado de Assis",
+	"São João", "Bahia", "Paraná", "Amazonas", "Voluntários da Pátria",
+	"Floriano Peixoto", "Benjamin Constant", "Visconde de Mauá", "Primeiro de Maio", "Carlos Gomes",
+}
+
+var neighborhoods = []string{
+	"Centro", "Jardim América", "Vila Nova", "Boa Vista", "Santa Cecília",
+	"Jardim Europa", "Vila Mariana", "São José", "Bela Vista", "Nossa Senhora Aparecida",
+	"Cidade Nova", "Jardim Primavera", "Santo Antônio", "Parque Industrial", "Vila Operária",
+	"Alto da Glória", "Jardim das Palmeiras", "Planalto", "Santa Mônica", "Industrial",
+}
+
+var complements = []string{"", "", "", "Apto 12", "Apto 101", "Apto 203", "Casa 2", "Bloco B", "Sala 5", "Fundos"}
@@ -0,0 +1,144 @@
+package cep
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/inovacc/toolkit/data/algorithm/random"
+)
+
+// Range is an inclusive range of CEPs, stored as 8-digit integers.
+type Range struct {
+	UF   string
+	Low  int
+	High int
+}
+
+// Contains reports whether the numeric CEP falls inside the range.
+func (r Range) Contains(cep int) bool {
+	return cep >= r.Low && cep <= r.High
+}
+
+// String returns the range in "#####-### a #####-###" form.
+func (r Range) String() string {
+	return fmt.Sprintf("%s a %s", FormatCEP(fmt.Sprintf("%08d", r.Low)), FormatCEP(fmt.Sprintf("%08d", r.High)))
+}
+
+// ufRanges is the Correios table of CEP ranges per federative unit.
+var ufRanges = []Range{
+	{"SP", 1000000, 19999999},
+	{"RJ", 20000000, 28999999},
+	{"ES", 29000000, 29999999},
+	{"MG", 30000000, 39999999},
+	{"BA", 40000000, 48999999},
+	{"SE", 49000000, 49999999},
+	{"PE", 50000000, 56999999},
+	{"AL", 57000000, 57999999},
+	{"PB", 58000000, 58999999},
+	{"RN", 59000000, 59999999},
+	{"CE", 60000000, 63999999},
+	{"PI", 64000000, 64999999},
+	{"MA", 65000000, 65999999},
+	{"PA", 66000000, 68899999},
+	{"AP", 68900000, 68999999},
+	{"AM", 69000000, 69299999},
+	{"RR", 69300000, 69399999},
+	{"AM", 69400000, 69899999},
+	{"AC", 69900000, 69999999},
+	{"DF", 70000000, 72799999},
+	{"GO", 72800000, 72999999},
+	{"DF", 73000000, 73699999},
+	{"GO", 73700000, 76799999},
+	{"RO", 76800000, 76999999},
+	{"TO", 77000000, 77999999},
+	{"MT", 78000000, 78899999},
+	{"MS", 79000000, 79999999},
+	{"PR", 80000000, 87999999},
+	{"SC", 88000000, 89999999},
+	{"RS", 90000000, 99999999},
+}
+
+// Ranges returns the CEP ranges assigned to the given UF, or nil if the UF is unknown.
+func Ranges(uf string) []Range {
+	uf = strings.ToUpper(strings.TrimSpace(uf))
+
+	var out []Range
+	for _, r := range ufRanges {
+		if r.UF == uf {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+// UFs returns the federative units in the order of their first CEP range.
+func UFs() []string {
+	seen := make(map[string]bool)
+	var out []string
+	for _, r := range ufRanges {
+		if !seen[r.UF] {
+			seen[r.UF] = true
+			out = append(out, r.UF)
+		}
+	}
+	return out
+}
+
+// UFFromCEP returns the federative unit a CEP belongs to.
+func UFFromCEP(cep string) (string, error) {
+	n, err := parseCEP(cep)
+	if err != nil {
+		return "", err
+	}
+
+	for _, r := range ufRanges {
+		if r.Contains(n) {
+			return r.UF, nil
+		}
+	}
+	return "", fmt.Errorf("CEP %s is outside every UF range", FormatCEP(cep))
+}
+
+// ValidateCEP reports whether a CEP is well formed and falls inside a range assigned to a UF.
+// Unlike IsValidCEP, which only checks the format, it rejects CEPs that cannot exist.
+func ValidateCEP(cep string) bool {
+	_, err := UFFromCEP(cep)
+	return err == nil
+}
+
+// GenerateCEP generates a random CEP, unformatted, inside one of the ranges of the given UF.
+// By default it uses crypto/rand; pass random.WithSeed or random.WithSource for reproducible output.
+func GenerateCEP(uf string, opts ...random.Option) (string, error) {
+	ranges := Ranges(uf)
+	if len(ranges) == 0 {
+		return "", fmt.Errorf("unknown UF: %q", uf)
+	}
+	return pickCEP(random.NewRand(opts...).IntN, ranges), nil
+}
+
+// pickCEP draws uniformly over the union of ranges, so larger ranges get proportionally more CEPs.
+func pickCEP(intN func(int) int, ranges []Range) string {
+	total := 0
+	for _, r := range ranges {
+		total += r.High - r.Low + 1
+	}
+
+	n := intN(total)
+	for _, r := range ranges {
+		size := r.High - r.Low + 1
+		if n < size {
+			return fmt.Sprintf("%08d", r.Low+n)
+		}
+		n -= size
+	}
+	return ""
+}
+
+func parseCEP(cep string) (int, error) {
+	clean := UnformatCEP(cep)
+	if !IsValidCEP(clean) {
+		return 0, fmt.Errorf("invalid CEP: %q", cep)
+	}
+	return strconv.Atoi(clean)
+}
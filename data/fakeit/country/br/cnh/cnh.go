@@ -0,0 +1,86 @@
+// Package cnh generates and validates CNH (Carteira Nacional de Habilitação) registration numbers.
+package cnh
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/inovacc/toolkit/data/algorithm/random"
+)
+
+// GenerateCNH generates a random, valid CNH number (11 digits).
+// By default it uses crypto/rand; pass random.WithSeed or random.WithSource for reproducible output.
+func GenerateCNH(opts ...random.Option) string {
+	rng := random.NewRand(opts...)
+
+	for {
+		var sb strings.Builder
+		for i := 0; i < 9; i++ {
+			sb.WriteByte(byte('0' + rng.IntN(10)))
+		}
+
+		base := sb.String()
+		if strings.Count(base, base[:1]) == 9 {
+			continue
+		}
+		dv1, dv2 := calculateDigits(base)
+		return fmt.Sprintf("%s%d%d", base, dv1, dv2)
+	}
+}
+
+// ValidateCNH verifies if a given CNH number is syntactically valid.
+func ValidateCNH(value string) bool {
+	cnh := UnformatCNH(value)
+	if len(cnh) != 11 || strings.Count(cnh, cnh[:1]) == 11 {
+		return false
+	}
+
+	dv1, dv2 := calculateDigits(cnh[:9])
+	return cnh[9] == byte('0'+dv1) && cnh[10] == byte('0'+dv2)
+}
+
+// FormatCNH returns the CNH as 11 digits. The number has no official punctuation,
+// so formatting only normalizes the input.
+func FormatCNH(cnh string) string {
+	cnh = UnformatCNH(cnh)
+	if len(cnh) != 11 {
+		return "Invalid CNH"
+	}
+	return cnh
+}
+
+// UnformatCNH removes all non-numeric characters from the CNH string.
+func UnformatCNH(cnh string) string {
+	re := regexp.MustCompile(`[^0-9]`)
+	return re.ReplaceAllString(cnh, "")
+}
+
+// calculateDigits computes both check digits. When the first digit overflows to 0,
+// a discount of 2 is carried into the second one.
+func calculateDigits(base string) (int, int) {
+	sum := 0
+	for i := 0; i < 9; i++ {
+		sum += int(base[i]-'0') * (9 - i)
+	}
+
+	discount := 0
+	dv1 := sum % 11
+	if dv1 >= 10 {
+		dv1, discount = 0, 2
+	}
+
+	sum = 0
+	for i := 0; i < 9; i++ {
+		sum += int(base[i]-'0') * (i + 1)
+	}
+
+	dv2 := sum%11 - discount
+	if dv2 < 0 {
+		dv2 += 11
+	}
+	if dv2 >= 10 {
+		dv2 = 0
+	}
+	return dv1, dv2
+}
@@ -0,0 +1,47 @@
+package cnh
+
+import (
+	"testing"
+
+	"github.com/inovacc/toolkit/data/algorithm/random"
+)
+
+func TestGenerateCNH(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		v := GenerateCNH()
+		if len(v) != 11 || !ValidateCNH(v) {
+			t.Errorf("Invalid CNH: %s", v)
+			return
+		}
+	}
+}
+
+func TestValidateCNH(t *testing.T) {
+	for _, v := range []string{"12345678900", "98765432109", "065.030.646-95"} {
+		if !ValidateCNH(v) {
+			t.Errorf("Invalid CNH: %s", v)
+		}
+	}
+
+	for _, v := range []string{"12345678901", "11111111111", "123456789"} {
+		if ValidateCNH(v) {
+			t.Errorf("Should be invalid CNH: %s", v)
+		}
+	}
+}
+
+func TestFormatCNH(t *testing.T) {
+	if v := FormatCNH("065.030.646-95"); v != "06503064695" {
+		t.Errorf("Incorrectly formatted CNH: %s", v)
+	}
+
+	if v := FormatCNH("123"); v != "Invalid CNH" {
+		t.Errorf("Expected Invalid CNH, got %s", v)
+	}
+}
+
+func TestGenerateCNHSeeded(t *testing.T) {
+	if a, b := GenerateCNH(random.WithSeed(42)), GenerateCNH(random.WithSeed(42)); a != b {
+		t.Errorf("same seed produced %s and %s", a, b)
+	}
+}
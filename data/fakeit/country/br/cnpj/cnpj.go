@@ -1,16 +1,19 @@
 // Package cnpj provides utilities for generating, validating, formatting,
-// and handling alphanumeric CNPJ identifiers based on modulo-11 checksum rules.
+// and handling CNPJ identifiers based on modulo-11 checksum rules.
+// Both the traditional numeric format and the alphanumeric format issued by the
+// Receita Federal from 2026 are supported; numeric CNPJs are a special case of the
+// alphanumeric rules, so a single validator accepts both.
 // Reference: https://www.serpro.gov.br/menu/noticias/videos/calculodvcnpjalfanaumerico.pdf
 
 package cnpj
 
 import (
 	"fmt"
-	"math/rand"
 	"regexp"
 	"strconv"
 	"strings"
-	"time"
+
+	"github.com/inovacc/toolkit/data/algorithm/random"
 )
 
 // Character-to-numeric value mapping for alphanumeric CNPJ (0–9 and A–Z)
@@ -44,41 +47,85 @@ func calculateCheckDigit(cnpj string) int {
 	return 11 - remainder
 }
 
+// Mode selects the character set used for the 12-character base of a generated CNPJ.
+type Mode int
+
+const (
+	// Alphanumeric generates bases mixing digits and uppercase letters (2026 format).
+	Alphanumeric Mode = iota
+	// Numeric generates traditional all-digit CNPJs.
+	Numeric
+)
+
+// String returns the mode name.
+func (m Mode) String() string {
+	switch m {
+	case Alphanumeric:
+		return "alphanumeric"
+	case Numeric:
+		return "numeric"
+	}
+	return fmt.Sprintf("Mode(%d)", int(m))
+}
+
 // GenerateCNPJ creates a random, valid alphanumeric CNPJ (14 characters) with checksum digits.
-func GenerateCNPJ() string {
-	var rng = rand.New(rand.NewSource(time.Now().UnixNano()))
-	var sb strings.Builder
+// By default it uses crypto/rand; pass random.WithSeed or random.WithSource for reproducible output.
+func GenerateCNPJ(opts ...random.Option) string {
+	return GenerateCNPJMode(Alphanumeric, opts...)
+}
 
-	for i := 0; i < 12; i++ {
-		if rng.Intn(2) == 0 {
-			sb.WriteByte(byte('0' + rng.Intn(10)))
-		} else {
-			sb.WriteByte(byte('A' + rng.Intn(26)))
+// GenerateCNPJMode creates a random, valid CNPJ whose base follows the given mode.
+// The two trailing check digits are always numeric.
+func GenerateCNPJMode(mode Mode, opts ...random.Option) string {
+	rng := random.NewRand(opts...)
+
+	for {
+		var sb strings.Builder
+		for i := 0; i < 12; i++ {
+			if mode == Alphanumeric && rng.IntN(2) == 1 {
+				sb.WriteByte(byte('A' + rng.IntN(26)))
+			} else {
+				sb.WriteByte(byte('0' + rng.IntN(10)))
+			}
 		}
-	}
 
-	cnpjBase := sb.String()
-	dv1 := calculateCheckDigit(cnpjBase)
-	dv2 := calculateCheckDigit(cnpjBase + strconv.Itoa(dv1))
+		cnpjBase := sb.String()
+		if strings.Count(cnpjBase, cnpjBase[:1]) == 12 {
+			continue
+		}
+		dv1 := calculateCheckDigit(cnpjBase)
+		dv2 := calculateCheckDigit(cnpjBase + strconv.Itoa(dv1))
 
-	return fmt.Sprintf("%s%d%d", cnpjBase, dv1, dv2)
+		return fmt.Sprintf("%s%d%d", cnpjBase, dv1, dv2)
+	}
 }
 
-// ValidateCNPJ checks whether a given alphanumeric CNPJ is valid by verifying both check digits.
+// ValidateCNPJ checks whether a given CNPJ, numeric or alphanumeric, is valid by verifying both check digits.
 func ValidateCNPJ(cnpj string) bool {
 	cnpj = UnformatCNPJ(cnpj)
-	if len(cnpj) != 14 {
+	if len(cnpj) != 14 || strings.Count(cnpj, cnpj[:1]) == 14 {
+		return false
+	}
+
+	// Letters are only allowed in the base; the check digits are always numeric
+	if cnpj[12] < '0' || cnpj[12] > '9' || cnpj[13] < '0' || cnpj[13] > '9' {
 		return false
 	}
 
 	base := cnpj[:12]
-	dv1, _ := strconv.Atoi(string(cnpj[12]))
-	dv2, _ := strconv.Atoi(string(cnpj[13]))
+	dv1 := int(cnpj[12] - '0')
+	dv2 := int(cnpj[13] - '0')
 
 	return calculateCheckDigit(base) == dv1 && calculateCheckDigit(base+strconv.Itoa(dv1)) == dv2
 }
 
-// FormatCNPJ applies the standard CNPJ mask "##.###.###/####-##" to a valid alphanumeric string.
+// IsAlphanumeric reports whether the CNPJ contains letters, i.e. uses the 2026 format.
+// It does not validate the check digits.
+func IsAlphanumeric(cnpj string) bool {
+	return strings.ContainsAny(UnformatCNPJ(cnpj), "ABCDEFGHIJKLMNOPQRSTUVWXYZ")
+}
+
+// FormatCNPJ applies the standard CNPJ mask "##.###.###/####-##" to a numeric or alphanumeric string.
 func FormatCNPJ(cnpj string) string {
 	cnpj = UnformatCNPJ(cnpj)
 	if len(cnpj) != 14 {
@@ -103,5 +150,5 @@ func FormatCNPJ(cnpj string) string {
 // UnformatCNPJ removes all non-alphanumeric characters and uppercases all letters in the CNPJ.
 func UnformatCNPJ(cnpj string) string {
 	re := regexp.MustCompile(`[^0-9A-Z]`)
-	return strings.ToUpper(re.ReplaceAllString(cnpj, ""))
+	return re.ReplaceAllString(strings.ToUpper(cnpj), "")
 }
@@ -1,6 +1,10 @@
 package cnpj
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/inovacc/toolkit/data/algorithm/random"
+)
 
 func TestGenerateCNPJ(t *testing.T) {
 	v := GenerateCNPJ()
@@ -63,3 +67,52 @@ func TestFormatCNPJ(t *testing.T) {
 		return
 	}
 }
+
+func TestGenerateCNPJMode(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		v := GenerateCNPJMode(Numeric)
+		if !ValidateCNPJ(v) || IsAlphanumeric(v) {
+			t.Errorf("Invalid numeric CNPJ: %s", v)
+			return
+		}
+
+		v = GenerateCNPJMode(Alphanumeric)
+		if !ValidateCNPJ(v) {
+			t.Errorf("Invalid alphanumeric CNPJ: %s", v)
+			return
+		}
+	}
+
+	a := GenerateCNPJMode(Numeric, random.WithSeed(42))
+	b := GenerateCNPJMode(Numeric, random.WithSeed(42))
+	if a != b {
+		t.Errorf("same seed produced %s and %s", a, b)
+	}
+}
+
+func TestValidateCNPJFormats(t *testing.T) {
+	// Examples published by the Receita Federal for both formats
+	for _, v := range []string{"11.222.333/0001-81", "12.ABC.345/01DE-35", "12.abc.345/01de-35"} {
+		if !ValidateCNPJ(v) {
+			t.Errorf("Invalid CNPJ: %s", v)
+		}
+	}
+
+	for _, v := range []string{"00000000000000", "12ABC34501DE3A", "11222333000182"} {
+		if ValidateCNPJ(v) {
+			t.Errorf("Should be invalid CNPJ: %s", v)
+		}
+	}
+
+	if !IsAlphanumeric("12.ABC.345/01DE-35") || IsAlphanumeric("11.222.333/0001-81") {
+		t.Error("IsAlphanumeric misclassified the format")
+	}
+
+	if v := UnformatCNPJ("12.abc.345/01de-35"); v != "12ABC34501DE35" {
+		t.Errorf("Incorrectly unformatted CNPJ: %s", v)
+	}
+
+	if v := FormatCNPJ("12ABC34501DE35"); v != "12.ABC.345/01DE-35" {
+		t.Errorf("Incorrectly formatted CNPJ: %s", v)
+	}
+}
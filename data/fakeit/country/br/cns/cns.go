@@ -0,0 +1,96 @@
+// Package cns generates and validates CNS (Cartão Nacional de Saúde) numbers.
+//
+// Definitive numbers start with 1 or 2 and are derived from a PIS-like base;
+// provisional numbers start with 7, 8 or 9. Both kinds satisfy the same weighted
+// modulo 11 check, which is what ValidateCNS verifies.
+package cns
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/inovacc/toolkit/data/algorithm/random"
+)
+
+// GenerateCNS generates a random, valid definitive CNS (15 digits starting with 1 or 2).
+// By default it uses crypto/rand; pass random.WithSeed or random.WithSource for reproducible output.
+func GenerateCNS(opts ...random.Option) string {
+	rng := random.NewRand(opts...)
+	var sb strings.Builder
+
+	sb.WriteByte(byte('1' + rng.IntN(2)))
+	for i := 0; i < 10; i++ {
+		sb.WriteByte(byte('0' + rng.IntN(10)))
+	}
+
+	base := sb.String()
+	sum := weightedSum(base)
+	dv := 11 - sum%11
+	if dv == 11 {
+		dv = 0
+	}
+	if dv == 10 {
+		dv = 11 - (sum+2)%11
+		return fmt.Sprintf("%s001%d", base, dv)
+	}
+	return fmt.Sprintf("%s000%d", base, dv)
+}
+
+// GenerateProvisionalCNS generates a random, valid provisional CNS (15 digits starting with 7, 8 or 9).
+func GenerateProvisionalCNS(opts ...random.Option) string {
+	rng := random.NewRand(opts...)
+
+	for {
+		var sb strings.Builder
+		sb.WriteByte(byte('7' + rng.IntN(3)))
+		for i := 0; i < 13; i++ {
+			sb.WriteByte(byte('0' + rng.IntN(10)))
+		}
+
+		base := sb.String()
+		// The last digit has weight 1, so it must complete the sum to a multiple of 11
+		if last := (11 - weightedSum(base)%11) % 11; last < 10 {
+			return fmt.Sprintf("%s%d", base, last)
+		}
+	}
+}
+
+// ValidateCNS verifies if a given CNS, definitive or provisional, is syntactically valid.
+func ValidateCNS(value string) bool {
+	cns := UnformatCNS(value)
+	if len(cns) != 15 || !strings.ContainsAny(cns[:1], "12789") {
+		return false
+	}
+	return weightedSum(cns)%11 == 0
+}
+
+// IsProvisional reports whether a CNS is a provisional number.
+func IsProvisional(value string) bool {
+	cns := UnformatCNS(value)
+	return len(cns) == 15 && strings.ContainsAny(cns[:1], "789")
+}
+
+// FormatCNS takes a CNS string (with or without formatting) and returns it in the formatted style: XXX XXXX XXXX XXXX
+func FormatCNS(cns string) string {
+	cns = UnformatCNS(cns)
+	if len(cns) != 15 {
+		return "Invalid CNS"
+	}
+	return cns[:3] + " " + cns[3:7] + " " + cns[7:11] + " " + cns[11:]
+}
+
+// UnformatCNS removes all non-numeric characters from the CNS string.
+func UnformatCNS(cns string) string {
+	re := regexp.MustCompile(`[^0-9]`)
+	return re.ReplaceAllString(cns, "")
+}
+
+// weightedSum multiplies each digit by its weight, 15 for the first digit down to 1.
+func weightedSum(digits string) int {
+	sum := 0
+	for i := 0; i < len(digits); i++ {
+		sum += int(digits[i]-'0') * (15 - i)
+	}
+	return sum
+}
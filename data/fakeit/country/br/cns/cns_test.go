@@ -0,0 +1,49 @@
+package cns
+
+import (
+	"testing"
+
+	"github.com/inovacc/toolkit/data/algorithm/random"
+)
+
+func TestGenerateCNS(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		v := GenerateCNS()
+		if len(v) != 15 || !ValidateCNS(v) || IsProvisional(v) {
+			t.Errorf("Invalid CNS: %s", v)
+			return
+		}
+
+		v = GenerateProvisionalCNS()
+		if len(v) != 15 || !ValidateCNS(v) || !IsProvisional(v) {
+			t.Errorf("Invalid provisional CNS: %s", v)
+			return
+		}
+	}
+}
+
+func TestValidateCNS(t *testing.T) {
+	for _, v := range []string{"123456789010000", "298 7654 3210 0018", "700000000000005"} {
+		if !ValidateCNS(v) {
+			t.Errorf("Invalid CNS: %s", v)
+		}
+	}
+
+	for _, v := range []string{"123456789010001", "323456789010000", "12345"} {
+		if ValidateCNS(v) {
+			t.Errorf("Should be invalid CNS: %s", v)
+		}
+	}
+}
+
+func TestFormatCNS(t *testing.T) {
+	if v := FormatCNS("298765432100018"); v != "298 7654 3210 0018" {
+		t.Errorf("Incorrectly formatted CNS: %s", v)
+	}
+}
+
+func TestGenerateCNSSeeded(t *testing.T) {
+	if a, b := GenerateCNS(random.WithSeed(42)), GenerateCNS(random.WithSeed(42)); a != b {
+		t.Errorf("same seed produced %s and %s", a, b)
+	}
+}
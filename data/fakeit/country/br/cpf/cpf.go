@@ -2,11 +2,11 @@ package cpf
 
 import (
 	"fmt"
-	"math/rand"
 	"regexp"
 	"strconv"
 	"strings"
-	"time"
+
+	"github.com/inovacc/toolkit/data/algorithm/random"
 )
 
 var notAccepted = []string{
@@ -17,12 +17,13 @@ var notAccepted = []string{
 }
 
 // GenerateCPF generates a random, valid CPF number in unformatted form (11 digits).
-func GenerateCPF() string {
-	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+// By default it uses crypto/rand; pass random.WithSeed or random.WithSource for reproducible output.
+func GenerateCPF(opts ...random.Option) string {
+	rng := random.NewRand(opts...)
 	var sb strings.Builder
 
 	for i := 0; i < 9; i++ {
-		sb.WriteByte(byte('0' + rng.Intn(10)))
+		sb.WriteByte(byte('0' + rng.IntN(10)))
 	}
 
 	cpfBase := sb.String()
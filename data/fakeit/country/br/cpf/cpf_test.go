@@ -1,6 +1,10 @@
 package cpf
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/inovacc/toolkit/data/algorithm/random"
+)
 
 func TestGenerateCPF(t *testing.T) {
 	v := GenerateCPF()
@@ -89,3 +93,14 @@ func TestOrigin(t *testing.T) {
 		return
 	}
 }
+
+func TestGenerateCPFSeeded(t *testing.T) {
+	if GenerateCPF(random.WithSeed(42)) != GenerateCPF(random.WithSeed(42)) {
+		t.Error("same seed produced different CPFs")
+	}
+
+	src := random.NewSeededSource(42)
+	if GenerateCPF(random.WithSource(src)) == GenerateCPF(random.WithSource(src)) {
+		t.Error("shared source produced the same CPF twice")
+	}
+}
@@ -0,0 +1,122 @@
+// Package ie generates and validates Inscrição Estadual (state taxpayer registration) numbers
+// for all 27 Brazilian federative units, following the check digit rules published by SINTEGRA.
+package ie
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/inovacc/toolkit/data/algorithm/random"
+)
+
+// GenerateIE generates a random, valid inscrição estadual for the given UF in unformatted form.
+// By default it uses crypto/rand; pass random.WithSeed or random.WithSource for reproducible output.
+func GenerateIE(uf string, opts ...random.Option) (string, error) {
+	rs, err := lookup(uf)
+	if err != nil {
+		return "", err
+	}
+
+	r := rs[0]
+	rng := random.NewRand(opts...)
+
+	for {
+		var sb strings.Builder
+		if len(r.prefixes) > 0 {
+			sb.WriteString(r.prefixes[rng.IntN(len(r.prefixes))])
+		}
+		for sb.Len() < r.length-r.checkDigits {
+			sb.WriteByte(byte('0' + rng.IntN(10)))
+		}
+
+		base := sb.String()
+		if strings.Count(base, base[:1]) == len(base) {
+			continue
+		}
+		ie := base + r.digits(base)
+		if r.valid(ie) {
+			return ie, nil
+		}
+	}
+}
+
+// ValidateIE verifies if a given inscrição estadual is syntactically valid for the UF.
+// Unknown UFs are never valid.
+func ValidateIE(uf, value string) bool {
+	rs, err := lookup(uf)
+	if err != nil {
+		return false
+	}
+
+	ie := UnformatIE(value)
+	for _, r := range rs {
+		if r.pad && len(ie) < r.length {
+			ie = strings.Repeat("0", r.length-len(ie)) + ie
+		}
+		if len(ie) == r.length && r.valid(ie) {
+			return true
+		}
+	}
+	return false
+}
+
+// FormatIE takes an inscrição estadual (with or without formatting) and returns it using the UF's mask,
+// e.g. "###.###.###.###" for SP or "##.###.###-#" for GO.
+func FormatIE(uf, ie string) string {
+	rs, err := lookup(uf)
+	if err != nil {
+		return "Invalid IE"
+	}
+
+	ie = UnformatIE(ie)
+	for _, r := range rs {
+		if r.pad && len(ie) < r.length {
+			ie = strings.Repeat("0", r.length-len(ie)) + ie
+		}
+		if len(ie) == r.length {
+			return applyMask(r.mask, ie)
+		}
+	}
+	return "Invalid IE"
+}
+
+// UnformatIE removes all non-numeric characters from the inscrição estadual string.
+func UnformatIE(ie string) string {
+	re := regexp.MustCompile(`[^0-9]`)
+	return re.ReplaceAllString(ie, "")
+}
+
+// UFs returns the sorted list of supported federative units.
+func UFs() []string {
+	ufs := make([]string, 0, len(rules))
+	for uf := range rules {
+		ufs = append(ufs, uf)
+	}
+	sort.Strings(ufs)
+	return ufs
+}
+
+func lookup(uf string) ([]rule, error) {
+	rs, ok := rules[strings.ToUpper(strings.TrimSpace(uf))]
+	if !ok {
+		return nil, fmt.Errorf("unknown UF: %q", uf)
+	}
+	return rs, nil
+}
+
+// applyMask replaces each '#' in mask with the next digit of value.
+func applyMask(mask, value string) string {
+	var sb strings.Builder
+	i := 0
+	for _, c := range mask {
+		if c == '#' {
+			sb.WriteByte(value[i])
+			i++
+			continue
+		}
+		sb.WriteRune(c)
+	}
+	return sb.String()
+}
@@ -0,0 +1,98 @@
+package ie
+
+import (
+	"testing"
+
+	"github.com/inovacc/toolkit/data/algorithm/random"
+)
+
+var samples = map[string][]string{
+	"AC": {"01.004.823/001-12"},
+	"AL": {"240000048"},
+	"AP": {"030123459"},
+	"BA": {"123456-63", "1000003-06"},
+	"CE": {"06000001-5"},
+	"DF": {"07300001001-09"},
+	"GO": {"10.987.654-7"},
+	"MA": {"120000385"},
+	"MT": {"0013000001-9"},
+	"MG": {"062.307.904/0081"},
+	"PA": {"15-999999-5"},
+	"PB": {"06000001-5"},
+	"PR": {"123.45678-50"},
+	"PE": {"0321418-40"},
+	"RJ": {"99.999.99-3"},
+	"RN": {"20.040.040-1", "20.0.040.040-0"},
+	"RS": {"224/3658792"},
+	"RO": {"0000000062521-3"},
+	"RR": {"24006628-1"},
+	"SC": {"251.040.852"},
+	"SP": {"110.042.490.114"},
+	"SE": {"27123456-3"},
+	"TO": {"29010227836"},
+}
+
+func TestValidateIE(t *testing.T) {
+	for uf, values := range samples {
+		for _, v := range values {
+			if !ValidateIE(uf, v) {
+				t.Errorf("Invalid IE for %s: %s", uf, v)
+			}
+		}
+	}
+
+	if ValidateIE("SP", "110.042.490.115") {
+		t.Errorf("Should be invalid IE: %s", "110.042.490.115")
+	}
+
+	if ValidateIE("XX", "110042490114") {
+		t.Error("Unknown UF should be invalid")
+	}
+}
+
+func TestGenerateIE(t *testing.T) {
+	for _, uf := range UFs() {
+		for i := 0; i < 50; i++ {
+			v, err := GenerateIE(uf)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !ValidateIE(uf, v) {
+				t.Fatalf("Invalid IE for %s: %s", uf, v)
+			}
+			if !ValidateIE(uf, FormatIE(uf, v)) {
+				t.Fatalf("Formatted IE for %s does not validate: %s", uf, FormatIE(uf, v))
+			}
+		}
+	}
+
+	if len(UFs()) != 27 {
+		t.Errorf("Expected 27 UFs, got %d", len(UFs()))
+	}
+
+	if _, err := GenerateIE("XX"); err == nil {
+		t.Error("Expected error for unknown UF")
+	}
+}
+
+func TestFormatIE(t *testing.T) {
+	if v := FormatIE("sp", "110042490114"); v != "110.042.490.114" {
+		t.Errorf("Incorrectly formatted IE: %s", v)
+	}
+
+	if v := FormatIE("MT", "130000019"); v != "0013000001-9" {
+		t.Errorf("Incorrectly formatted IE: %s", v)
+	}
+
+	if v := FormatIE("SP", "123"); v != "Invalid IE" {
+		t.Errorf("Expected Invalid IE, got %s", v)
+	}
+}
+
+func TestGenerateIESeeded(t *testing.T) {
+	a, _ := GenerateIE("MG", random.WithSeed(7))
+	b, _ := GenerateIE("MG", random.WithSeed(7))
+	if a != b {
+		t.Errorf("same seed produced %s and %s", a, b)
+	}
+}
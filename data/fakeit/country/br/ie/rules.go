@@ -0,0 +1,264 @@
+package ie
+
+import (
+	"strconv"
+	"strings"
+)
+
+// rule describes one accepted layout of an inscrição estadual.
+type rule struct {
+	length      int
+	checkDigits int
+	// prefixes constrains the leading digits; generation picks one at random.
+	prefixes []string
+	mask     string
+	// pad left-pads shorter inputs with zeros before validating (MT).
+	pad bool
+	// digits computes the check digits for the leading length-checkDigits digits.
+	digits func(base string) string
+	// extra is an optional additional constraint on the full number.
+	extra func(ie string) bool
+}
+
+func (r rule) valid(ie string) bool {
+	if len(ie) != r.length {
+		return false
+	}
+	if len(r.prefixes) > 0 && !hasAnyPrefix(ie, r.prefixes) {
+		return false
+	}
+	if r.extra != nil && !r.extra(ie) {
+		return false
+	}
+	base := ie[:r.length-r.checkDigits]
+	return ie[len(base):] == r.digits(base)
+}
+
+// rules holds the layouts of every UF. When a UF has several layouts, the first one is used for generation.
+var rules = map[string][]rule{
+	"AC": {{length: 13, checkDigits: 2, prefixes: []string{"01"}, mask: "##.###.###/###-##", digits: twoMod11}},
+	"AL": {{length: 9, checkDigits: 1, prefixes: []string{"240", "243", "245", "247", "248"}, mask: "#########", digits: times10Mod11}},
+	"AP": {{length: 9, checkDigits: 1, prefixes: []string{"03"}, mask: "#########", digits: apDigit}},
+	"AM": {{length: 9, checkDigits: 1, mask: "##.###.###-#", digits: oneMod11}},
+	"BA": {
+		{length: 9, checkDigits: 2, mask: "#######-##", digits: baDigits},
+		{length: 8, checkDigits: 2, mask: "######-##", digits: baDigits},
+	},
+	"CE": {{length: 9, checkDigits: 1, mask: "########-#", digits: oneMod11}},
+	"DF": {{length: 13, checkDigits: 2, prefixes: []string{"07"}, mask: "###########-##", digits: twoMod11}},
+	"ES": {{length: 9, checkDigits: 1, mask: "########-#", digits: oneMod11}},
+	"GO": {{length: 9, checkDigits: 1, prefixes: []string{"10", "11", "15"}, mask: "##.###.###-#", digits: goDigit}},
+	"MA": {{length: 9, checkDigits: 1, prefixes: []string{"12"}, mask: "#########", digits: oneMod11}},
+	"MT": {{length: 11, checkDigits: 1, mask: "##########-#", pad: true, digits: oneMod11}},
+	"MS": {{length: 9, checkDigits: 1, prefixes: []string{"28", "50"}, mask: "##.###.###-#", digits: oneMod11}},
+	"MG": {{length: 13, checkDigits: 2, mask: "###.###.###/####", digits: mgDigits}},
+	"PA": {{length: 9, checkDigits: 1, prefixes: []string{"15"}, mask: "##-######-#", digits: oneMod11}},
+	"PB": {{length: 9, checkDigits: 1, mask: "########-#", digits: oneMod11}},
+	"PR": {{length: 10, checkDigits: 2, mask: "########-##", digits: prDigits}},
+	"PE": {{length: 9, checkDigits: 2, mask: "#######-##", digits: twoMod11}},
+	"PI": {{length: 9, checkDigits: 1, prefixes: []string{"19"}, mask: "#########", digits: oneMod11}},
+	"RJ": {{length: 8, checkDigits: 1, mask: "##.###.##-#", digits: rjDigit}},
+	"RN": {
+		{length: 9, checkDigits: 1, prefixes: []string{"20"}, mask: "##.###.###-#", digits: times10Mod11},
+		{length: 10, checkDigits: 1, prefixes: []string{"20"}, mask: "##.#.###.###-#", digits: times10Mod11},
+	},
+	"RS": {{length: 10, checkDigits: 1, mask: "###/#######", digits: oneMod11, extra: rsMunicipality}},
+	"RO": {{length: 14, checkDigits: 1, mask: "#############-#", digits: roDigit}},
+	"RR": {{length: 9, checkDigits: 1, prefixes: []string{"24"}, mask: "########-#", digits: rrDigit}},
+	"SC": {{length: 9, checkDigits: 1, mask: "###.###.###", digits: oneMod11}},
+	"SP": {{length: 12, checkDigits: 1, mask: "###.###.###.###", digits: spDigit, extra: spNinthDigit}},
+	"SE": {{length: 9, checkDigits: 1, mask: "########-#", digits: oneMod11}},
+	"TO": {
+		{length: 9, checkDigits: 1, mask: "#########", digits: oneMod11},
+		{length: 11, checkDigits: 1, mask: "###########", digits: toLegacyDigit, extra: toLegacyCategory},
+	},
+}
+
+// cyclic returns n weights that run 2, 3, ..., max from the rightmost digit and wrap around.
+func cyclic(n, max int) []int {
+	w := make([]int, n)
+	for i := range w {
+		w[n-1-i] = 2 + i%(max-1)
+	}
+	return w
+}
+
+func weightedSum(digits string, weights []int) int {
+	sum := 0
+	for i, w := range weights {
+		sum += int(digits[i]-'0') * w
+	}
+	return sum
+}
+
+// mod11 is the most common rule: 11 minus the remainder, where 10 and 11 become 0.
+func mod11(sum int) int {
+	r := sum % 11
+	if r < 2 {
+		return 0
+	}
+	return 11 - r
+}
+
+func oneMod11(base string) string {
+	return strconv.Itoa(mod11(weightedSum(base, cyclic(len(base), 9))))
+}
+
+// twoMod11 appends two mod11 digits, the second computed over the base plus the first.
+func twoMod11(base string) string {
+	dv1 := strconv.Itoa(mod11(weightedSum(base, cyclic(len(base), 9))))
+	base += dv1
+	return dv1 + strconv.Itoa(mod11(weightedSum(base, cyclic(len(base), 9))))
+}
+
+func times10Mod11(base string) string {
+	dv := weightedSum(base, cyclic(len(base), 99)) * 10 % 11
+	if dv == 10 {
+		dv = 0
+	}
+	return strconv.Itoa(dv)
+}
+
+// apDigit adds a range-dependent offset to the sum and uses a range-dependent digit when it overflows to 11.
+func apDigit(base string) string {
+	n, _ := strconv.Atoi(base)
+	p, d := 0, 0
+	switch {
+	case n >= 3000001 && n <= 3017000:
+		p, d = 5, 0
+	case n >= 3017001 && n <= 3019022:
+		p, d = 9, 1
+	}
+
+	dv := 11 - (p+weightedSum(base, cyclic(len(base), 9)))%11
+	switch dv {
+	case 10:
+		dv = 0
+	case 11:
+		dv = d
+	}
+	return strconv.Itoa(dv)
+}
+
+// baDigits computes the two BA check digits. The second digit is calculated first,
+// then the first one over the base plus the second. Modulo 10 is used when the
+// leading digit (the second for 9-digit numbers) is 0-5 or 8, modulo 11 otherwise.
+func baDigits(base string) string {
+	lead := base[0]
+	if len(base) == 7 {
+		lead = base[1]
+	}
+
+	mod := 10
+	if lead == '6' || lead == '7' || lead == '9' {
+		mod = 11
+	}
+	calc := func(digits string) int {
+		r := weightedSum(digits, cyclic(len(digits), 9)) % mod
+		if mod == 10 {
+			return (10 - r) % 10
+		}
+		return mod11(r)
+	}
+
+	dv2 := calc(base)
+	dv1 := calc(base + strconv.Itoa(dv2))
+	return strconv.Itoa(dv1) + strconv.Itoa(dv2)
+}
+
+// goDigit uses 1 when the remainder is 1 and the base falls in 10103105-10119997.
+func goDigit(base string) string {
+	sum := weightedSum(base, cyclic(len(base), 9))
+	if sum%11 == 1 {
+		if n, _ := strconv.Atoi(base); n >= 10103105 && n <= 10119997 {
+			return "1"
+		}
+	}
+	return strconv.Itoa(mod11(sum))
+}
+
+// mgDigits computes the MG check digits. The first one inserts a zero after the
+// municipality code, multiplies alternately by 1 and 2, sums the digits of the
+// products and takes the distance to the next multiple of ten.
+func mgDigits(base string) string {
+	padded := base[:3] + "0" + base[3:]
+
+	var products strings.Builder
+	for i := 0; i < len(padded); i++ {
+		products.WriteString(strconv.Itoa(int(padded[i]-'0') * (1 + i%2)))
+	}
+
+	sum := 0
+	for _, c := range products.String() {
+		sum += int(c - '0')
+	}
+	dv1 := strconv.Itoa((10 - sum%10) % 10)
+
+	base += dv1
+	return dv1 + strconv.Itoa(mod11(weightedSum(base, cyclic(len(base), 11))))
+}
+
+func prDigits(base string) string {
+	dv1 := strconv.Itoa(mod11(weightedSum(base, cyclic(len(base), 7))))
+	base += dv1
+	return dv1 + strconv.Itoa(mod11(weightedSum(base, cyclic(len(base), 7))))
+}
+
+func rjDigit(base string) string {
+	return strconv.Itoa(mod11(weightedSum(base, cyclic(len(base), 7))))
+}
+
+// roDigit subtracts 10 instead of returning 0 when the result overflows.
+func roDigit(base string) string {
+	dv := 11 - weightedSum(base, cyclic(len(base), 9))%11
+	if dv >= 10 {
+		dv -= 10
+	}
+	return strconv.Itoa(dv)
+}
+
+// rrDigit uses ascending weights 1 to 8 and modulo 9.
+func rrDigit(base string) string {
+	sum := 0
+	for i := 0; i < len(base); i++ {
+		sum += int(base[i]-'0') * (i + 1)
+	}
+	return strconv.Itoa(sum % 9)
+}
+
+// spDigit computes the final SP industrial/commercial check digit, the last digit of the remainder modulo 11.
+func spDigit(base string) string {
+	return strconv.Itoa(weightedSum(base, []int{3, 2, 10, 9, 8, 7, 6, 5, 4, 3, 2}) % 11 % 10)
+}
+
+// spNinthDigit checks the SP check digit embedded in the ninth position.
+func spNinthDigit(ie string) bool {
+	return ie[8] == byte('0'+weightedSum(ie, []int{1, 3, 4, 5, 6, 7, 8, 10})%11%10)
+}
+
+// toLegacyDigit skips the two category digits of the legacy 11-digit TO layout.
+func toLegacyDigit(base string) string {
+	return oneMod11(base[:2] + base[4:])
+}
+
+func toLegacyCategory(ie string) bool {
+	switch ie[2:4] {
+	case "01", "02", "03", "99":
+		return true
+	}
+	return false
+}
+
+func rsMunicipality(ie string) bool {
+	n, _ := strconv.Atoi(ie[:3])
+	return n >= 1 && n <= 467
+}
+
+func hasAnyPrefix(s string, prefixes []string) bool {
+	for _, p := range prefixes {
+		if strings.HasPrefix(s, p) {
+			return true
+		}
+	}
+	return false
+}
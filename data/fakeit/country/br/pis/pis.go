@@ -0,0 +1,69 @@
+// Package pis generates and validates PIS/PASEP/NIT numbers. The three share the
+// same 11-digit layout and check digit, so every function accepts any of them.
+package pis
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/inovacc/toolkit/data/algorithm/random"
+)
+
+var weights = []int{3, 2, 9, 8, 7, 6, 5, 4, 3, 2}
+
+// GeneratePIS generates a random, valid PIS/PASEP/NIT in unformatted form (11 digits).
+// By default it uses crypto/rand; pass random.WithSeed or random.WithSource for reproducible output.
+func GeneratePIS(opts ...random.Option) string {
+	rng := random.NewRand(opts...)
+
+	for {
+		var sb strings.Builder
+		for i := 0; i < 10; i++ {
+			sb.WriteByte(byte('0' + rng.IntN(10)))
+		}
+
+		base := sb.String()
+		if strings.Count(base, base[:1]) == 10 {
+			continue
+		}
+		return fmt.Sprintf("%s%d", base, calculateDigit(base))
+	}
+}
+
+// ValidatePIS verifies if a given PIS/PASEP/NIT is syntactically valid.
+func ValidatePIS(value string) bool {
+	pis := UnformatPIS(value)
+	if len(pis) != 11 || strings.Count(pis, pis[:1]) == 11 {
+		return false
+	}
+	return pis[10] == byte('0'+calculateDigit(pis[:10]))
+}
+
+// FormatPIS takes a PIS string (with or without formatting) and returns it in the formatted style: XXX.XXXXX.XX-X
+func FormatPIS(pis string) string {
+	pis = UnformatPIS(pis)
+	if len(pis) != 11 {
+		return "Invalid PIS"
+	}
+	return pis[:3] + "." + pis[3:8] + "." + pis[8:10] + "-" + pis[10:]
+}
+
+// UnformatPIS removes all non-numeric characters from the PIS string.
+func UnformatPIS(pis string) string {
+	re := regexp.MustCompile(`[^0-9]`)
+	return re.ReplaceAllString(pis, "")
+}
+
+// calculateDigit computes the modulo 11 check digit of the first 10 digits.
+func calculateDigit(base string) int {
+	sum := 0
+	for i, w := range weights {
+		sum += int(base[i]-'0') * w
+	}
+	dv := 11 - sum%11
+	if dv >= 10 {
+		return 0
+	}
+	return dv
+}
@@ -0,0 +1,47 @@
+package pis
+
+import (
+	"testing"
+
+	"github.com/inovacc/toolkit/data/algorithm/random"
+)
+
+func TestGeneratePIS(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		v := GeneratePIS()
+		if len(v) != 11 || !ValidatePIS(v) {
+			t.Errorf("Invalid PIS: %s", v)
+			return
+		}
+	}
+}
+
+func TestValidatePIS(t *testing.T) {
+	for _, v := range []string{"12045423079", "170.34501.08-2"} {
+		if !ValidatePIS(v) {
+			t.Errorf("Invalid PIS: %s", v)
+		}
+	}
+
+	for _, v := range []string{"12045423078", "00000000000", "1204542307"} {
+		if ValidatePIS(v) {
+			t.Errorf("Should be invalid PIS: %s", v)
+		}
+	}
+}
+
+func TestFormatPIS(t *testing.T) {
+	if v := FormatPIS("12045423079"); v != "120.45423.07-9" {
+		t.Errorf("Incorrectly formatted PIS: %s", v)
+	}
+
+	if v := UnformatPIS("120.45423.07-9"); v != "12045423079" {
+		t.Errorf("Incorrectly unformatted PIS: %s", v)
+	}
+}
+
+func TestGeneratePISSeeded(t *testing.T) {
+	if a, b := GeneratePIS(random.WithSeed(42)), GeneratePIS(random.WithSeed(42)); a != b {
+		t.Errorf("same seed produced %s and %s", a, b)
+	}
+}
@@ -0,0 +1,116 @@
+package pix
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/inovacc/toolkit/data/algorithm/random"
+	"github.com/inovacc/toolkit/data/fakeit/country/br/cnpj"
+	"github.com/inovacc/toolkit/data/fakeit/country/br/cpf"
+	"github.com/inovacc/toolkit/data/uid"
+)
+
+// KeyType identifies the kind of PIX key registered in the DICT.
+type KeyType int
+
+const (
+	KeyInvalid KeyType = iota
+	KeyCPF
+	KeyCNPJ
+	KeyEmail
+	KeyPhone
+	KeyEVP
+)
+
+var keyTypeNames = map[KeyType]string{
+	KeyInvalid: "invalid",
+	KeyCPF:     "cpf",
+	KeyCNPJ:    "cnpj",
+	KeyEmail:   "email",
+	KeyPhone:   "phone",
+	KeyEVP:     "evp",
+}
+
+// String returns the lowercase name of the key type.
+func (k KeyType) String() string {
+	if name, ok := keyTypeNames[k]; ok {
+		return name
+	}
+	return fmt.Sprintf("KeyType(%d)", int(k))
+}
+
+var (
+	emailPattern = regexp.MustCompile(`^[a-z0-9.!#$&'*+/=?^_{|}~-]+@[a-z0-9](?:[a-z0-9-]{0,61}[a-z0-9])?(?:\.[a-z0-9](?:[a-z0-9-]{0,61}[a-z0-9])?)+$`)
+	phonePattern = regexp.MustCompile(`^\+55[1-9][0-9]9?[0-9]{8}$`)
+	digits       = regexp.MustCompile(`^[0-9]+$`)
+)
+
+// ErrInvalidKey is returned when a string is not a valid PIX key of any type.
+var ErrInvalidKey = errors.New("invalid PIX key")
+
+// DetectKeyType classifies a PIX key as it is written in a BR Code: CPF and CNPJ as
+// bare digits, email in lowercase, phone in E.164 (+55...) and EVP as a UUID.
+func DetectKeyType(key string) (KeyType, error) {
+	switch {
+	case len(key) == 11 && digits.MatchString(key) && cpf.ValidateCPF(key):
+		return KeyCPF, nil
+	case len(key) == 14 && cnpj.UnformatCNPJ(key) == key && cnpj.ValidateCNPJ(key):
+		return KeyCNPJ, nil
+	case phonePattern.MatchString(key):
+		return KeyPhone, nil
+	case isUUID(key):
+		return KeyEVP, nil
+	case len(key) <= 77 && emailPattern.MatchString(key):
+		return KeyEmail, nil
+	}
+	return KeyInvalid, ErrInvalidKey
+}
+
+// isUUID accepts only the canonical 36-character form, unlike uid.ParseUUID.
+func isUUID(key string) bool {
+	if len(key) != 36 || strings.Count(key, "-") != 4 {
+		return false
+	}
+	_, err := uid.ParseUUID(key)
+	return err == nil
+}
+
+// ValidateKey reports whether key is a valid PIX key of any type.
+func ValidateKey(key string) bool {
+	_, err := DetectKeyType(key)
+	return err == nil
+}
+
+// GenerateKey generates a random, valid PIX key of the given type.
+// By default it uses crypto/rand; pass random.WithSeed or random.WithSource for reproducible output.
+func GenerateKey(kind KeyType, opts ...random.Option) (string, error) {
+	rng := random.NewRand(opts...)
+	switch kind {
+	case KeyCPF:
+		return cpf.GenerateCPF(opts...), nil
+	case KeyCNPJ:
+		return cnpj.GenerateCNPJMode(cnpj.Numeric, opts...), nil
+	case KeyEmail:
+		var sb strings.Builder
+		for i := 0; i < 10; i++ {
+			sb.WriteByte(byte('a' + rng.IntN(26)))
+		}
+		return sb.String() + "@example.com", nil
+	case KeyPhone:
+		return fmt.Sprintf("+55%d%d9%08d", 1+rng.IntN(9), 1+rng.IntN(9), rng.IntN(100000000)), nil
+	case KeyEVP:
+		b := make([]byte, 16)
+		for i := range b {
+			b[i] = byte(rng.Uint32())
+		}
+		u, err := uid.NewRandomFromReader(bytes.NewReader(b))
+		if err != nil {
+			return "", err
+		}
+		return u.String(), nil
+	}
+	return "", fmt.Errorf("unsupported key type: %s", kind)
+}
@@ -0,0 +1,241 @@
+// Package pix builds and parses PIX BR Code payloads, the EMV QR Code Merchant
+// Presented Mode strings shown as "copia e cola" and encoded in PIX QR codes.
+//
+// A static payload carries the PIX key directly; a dynamic payload carries the URL
+// of a charge hosted by the receiver's PSP instead. Both end with a CRC16-CCITT checksum.
+package pix
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/inovacc/toolkit/image/qrcode"
+)
+
+// Field IDs defined by the BR Code specification.
+const (
+	idPayloadFormat   = "00"
+	idPointOfInit     = "01"
+	idMerchantAccount = "26"
+	idMCC             = "52"
+	idCurrency        = "53"
+	idAmount          = "54"
+	idCountry         = "58"
+	idMerchantName    = "59"
+	idMerchantCity    = "60"
+	idPostalCode      = "61"
+	idAdditionalData  = "62"
+	idCRC             = "63"
+
+	idGUI         = "00"
+	idKey         = "01"
+	idDescription = "02"
+	idURL         = "25"
+	idTxID        = "05"
+)
+
+const (
+	gui = "br.gov.bcb.pix"
+	// noTxID is the txid placeholder used when a static charge has no identifier and by every dynamic charge.
+	noTxID = "***"
+)
+
+var txIDPattern = regexp.MustCompile(`^[A-Za-z0-9]{1,25}$`)
+
+// ErrChecksum is returned by Parse when the CRC16 of the payload does not match.
+var ErrChecksum = errors.New("pix: checksum mismatch")
+
+// Payload holds the data of a PIX charge.
+type Payload struct {
+	// Key is the receiver's PIX key. Required for static payloads.
+	Key string
+	// URL is the charge location, without scheme. When set the payload is dynamic and Key is not encoded.
+	URL string
+	// Description is an optional message to the payer (static payloads only).
+	Description  string
+	MerchantName string
+	MerchantCity string
+	PostalCode   string
+	// Amount is the charge value in BRL. Zero lets the payer choose the amount.
+	Amount float64
+	// TxID identifies the charge in static payloads (up to 25 alphanumeric characters).
+	TxID string
+	// OneTime marks the payload as usable only once (point of initiation method 12).
+	OneTime bool
+}
+
+// Dynamic reports whether the payload points to a charge URL instead of carrying a key.
+func (p *Payload) Dynamic() bool {
+	return p.URL != ""
+}
+
+// Validate checks the fields against the limits of the BR Code specification.
+func (p *Payload) Validate() error {
+	if p.Dynamic() {
+		if strings.Contains(p.URL, "://") {
+			return errors.New("pix: URL must not include the scheme")
+		}
+	} else if !ValidateKey(p.Key) {
+		return fmt.Errorf("pix: %w: %q", ErrInvalidKey, p.Key)
+	}
+
+	switch {
+	case p.MerchantName == "" || len(p.MerchantName) > 25:
+		return errors.New("pix: merchant name must have 1 to 25 characters")
+	case p.MerchantCity == "" || len(p.MerchantCity) > 15:
+		return errors.New("pix: merchant city must have 1 to 15 characters")
+	case p.Amount < 0 || len(formatAmount(p.Amount)) > 13:
+		return fmt.Errorf("pix: invalid amount %v", p.Amount)
+	case p.TxID != "" && p.TxID != noTxID && !txIDPattern.MatchString(p.TxID):
+		return fmt.Errorf("pix: invalid txid %q", p.TxID)
+	case p.Dynamic() && p.TxID != "" && p.TxID != noTxID:
+		return errors.New("pix: dynamic payloads must not carry a txid")
+	}
+
+	if len(p.merchantAccount()) > 99 {
+		return errors.New("pix: merchant account information exceeds 99 characters")
+	}
+	return nil
+}
+
+// Encode validates the payload and returns the BR Code string, checksum included.
+func (p *Payload) Encode() (string, error) {
+	if err := p.Validate(); err != nil {
+		return "", err
+	}
+
+	pointOfInit := ""
+	if p.OneTime {
+		pointOfInit = "12"
+	}
+
+	amount := ""
+	if p.Amount > 0 {
+		amount = formatAmount(p.Amount)
+	}
+
+	txID := p.TxID
+	if txID == "" {
+		txID = noTxID
+	}
+
+	s := encodeTLV(
+		field{idPayloadFormat, "01"},
+		field{idPointOfInit, pointOfInit},
+		field{idMerchantAccount, p.merchantAccount()},
+		field{idMCC, "0000"},
+		field{idCurrency, "986"},
+		field{idAmount, amount},
+		field{idCountry, "BR"},
+		field{idMerchantName, p.MerchantName},
+		field{idMerchantCity, p.MerchantCity},
+		field{idPostalCode, p.PostalCode},
+		field{idAdditionalData, encodeTLV(field{idTxID, txID})},
+	) + idCRC + "04"
+
+	return s + fmt.Sprintf("%04X", crc16(s)), nil
+}
+
+// QRCode encodes the payload and renders it through image/qrcode.
+func (p *Payload) QRCode() (*qrcode.Qrcode, error) {
+	s, err := p.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	qr := qrcode.NewQrcode()
+	if err := qr.Generate(s); err != nil {
+		return nil, err
+	}
+	return qr, nil
+}
+
+func (p *Payload) merchantAccount() string {
+	if p.Dynamic() {
+		return encodeTLV(field{idGUI, gui}, field{idURL, p.URL})
+	}
+	return encodeTLV(field{idGUI, gui}, field{idKey, p.Key}, field{idDescription, p.Description})
+}
+
+// Parse decodes a BR Code string, verifying its checksum and PIX merchant account.
+func Parse(code string) (*Payload, error) {
+	code = strings.TrimSpace(code)
+	if len(code) < 8 || code[len(code)-8:len(code)-4] != idCRC+"04" {
+		return nil, errors.New("pix: missing CRC field")
+	}
+
+	want, err := strconv.ParseUint(code[len(code)-4:], 16, 16)
+	if err != nil {
+		return nil, fmt.Errorf("pix: invalid CRC: %w", err)
+	}
+	if crc16(code[:len(code)-4]) != uint16(want) {
+		return nil, ErrChecksum
+	}
+
+	fields, err := parseTLV(code)
+	if err != nil {
+		return nil, fmt.Errorf("pix: %w", err)
+	}
+
+	p := &Payload{}
+	pixAccount := false
+	for _, f := range fields {
+		switch f.id {
+		case idPayloadFormat:
+			if f.value != "01" {
+				return nil, fmt.Errorf("pix: unsupported payload format %q", f.value)
+			}
+		case idPointOfInit:
+			p.OneTime = f.value == "12"
+		case idMerchantAccount:
+			sub, err := parseTLV(f.value)
+			if err != nil {
+				return nil, fmt.Errorf("pix: merchant account: %w", err)
+			}
+			for _, s := range sub {
+				switch s.id {
+				case idGUI:
+					pixAccount = strings.EqualFold(s.value, gui)
+				case idKey:
+					p.Key = s.value
+				case idDescription:
+					p.Description = s.value
+				case idURL:
+					p.URL = s.value
+				}
+			}
+		case idAmount:
+			if p.Amount, err = strconv.ParseFloat(f.value, 64); err != nil {
+				return nil, fmt.Errorf("pix: invalid amount %q", f.value)
+			}
+		case idMerchantName:
+			p.MerchantName = f.value
+		case idMerchantCity:
+			p.MerchantCity = f.value
+		case idPostalCode:
+			p.PostalCode = f.value
+		case idAdditionalData:
+			sub, err := parseTLV(f.value)
+			if err != nil {
+				return nil, fmt.Errorf("pix: additional data: %w", err)
+			}
+			for _, s := range sub {
+				if s.id == idTxID {
+					p.TxID = s.value
+				}
+			}
+		}
+	}
+
+	if !pixAccount {
+		return nil, errors.New("pix: payload has no PIX merchant account")
+	}
+	return p, nil
+}
+
+func formatAmount(amount float64) string {
+	return strconv.FormatFloat(amount, 'f', 2, 64)
+}
@@ -0,0 +1,125 @@
+package pix
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/inovacc/toolkit/data/algorithm/random"
+)
+
+// Static example from the BR Code manual published by the Banco Central do Brasil
+const manualExample = "00020126580014br.gov.bcb.pix0136123e4567-e12b-12d1-a456-4266554400005204000053039865802BR5913Fulano de Tal6008BRASILIA62070503***63041D3D"
+
+func TestEncode(t *testing.T) {
+	p := &Payload{
+		Key:          "123e4567-e12b-12d1-a456-426655440000",
+		MerchantName: "Fulano de Tal",
+		MerchantCity: "BRASILIA",
+	}
+
+	s, err := p.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s != manualExample {
+		t.Errorf("Encode() = %s, want %s", s, manualExample)
+	}
+}
+
+func TestParse(t *testing.T) {
+	p, err := Parse(manualExample)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Key != "123e4567-e12b-12d1-a456-426655440000" || p.MerchantName != "Fulano de Tal" || p.MerchantCity != "BRASILIA" || p.Dynamic() {
+		t.Errorf("unexpected payload: %+v", p)
+	}
+
+	if _, err := Parse(manualExample[:len(manualExample)-1] + "E"); !errors.Is(err, ErrChecksum) {
+		t.Errorf("expected checksum error, got %v", err)
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	payloads := []*Payload{
+		{Key: "+5561912345678", Description: "Pedido 42", MerchantName: "Loja", MerchantCity: "SAO PAULO", PostalCode: "01310100", Amount: 12.5, TxID: "PEDIDO42"},
+		{URL: "pix.example.com/qr/v2/9d36b84f", MerchantName: "Loja", MerchantCity: "RIO DE JANEIRO", Amount: 1000, OneTime: true},
+	}
+
+	for _, want := range payloads {
+		s, err := want.Encode()
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := Parse(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got.Key != want.Key || got.URL != want.URL || got.Description != want.Description ||
+			got.Amount != want.Amount || got.PostalCode != want.PostalCode || got.OneTime != want.OneTime {
+			t.Errorf("round trip mismatch: got %+v, want %+v", got, want)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	cases := []*Payload{
+		{Key: "not a key", MerchantName: "Loja", MerchantCity: "SAO PAULO"},
+		{Key: "12345678909", MerchantName: "", MerchantCity: "SAO PAULO"},
+		{Key: "12345678909", MerchantName: "Loja", MerchantCity: "SAO PAULO", TxID: "has space"},
+		{Key: "12345678909", MerchantName: "Loja", MerchantCity: "SAO PAULO", Amount: -1},
+		{URL: "https://pix.example.com/qr", MerchantName: "Loja", MerchantCity: "SAO PAULO"},
+	}
+
+	for _, p := range cases {
+		if err := p.Validate(); err == nil {
+			t.Errorf("expected validation error for %+v", p)
+		}
+	}
+}
+
+func TestDetectKeyType(t *testing.T) {
+	cases := map[string]KeyType{
+		"12345678909":                          KeyCPF,
+		"11222333000181":                       KeyCNPJ,
+		"fulano@example.com":                   KeyEmail,
+		"+5561912345678":                       KeyPhone,
+		"123e4567-e12b-12d1-a456-426655440000": KeyEVP,
+	}
+	for key, want := range cases {
+		if got, err := DetectKeyType(key); err != nil || got != want {
+			t.Errorf("DetectKeyType(%q) = %s, %v; want %s", key, got, err, want)
+		}
+	}
+
+	for _, key := range []string{"12345678900", "61912345678", "Fulano@Example", ""} {
+		if ValidateKey(key) {
+			t.Errorf("Should be invalid key: %q", key)
+		}
+	}
+}
+
+func TestGenerateKey(t *testing.T) {
+	for _, kind := range []KeyType{KeyCPF, KeyCNPJ, KeyEmail, KeyPhone, KeyEVP} {
+		key, err := GenerateKey(kind, random.WithSeed(1))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, err := DetectKeyType(key); err != nil || got != kind {
+			t.Errorf("GenerateKey(%s) = %q detected as %s, %v", kind, key, got, err)
+		}
+	}
+}
+
+func TestQRCode(t *testing.T) {
+	p := &Payload{Key: "fulano@example.com", MerchantName: "Fulano de Tal", MerchantCity: "BRASILIA", Amount: 10}
+
+	qr, err := p.QRCode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if png, err := qr.ToPNG(256); err != nil || len(png) == 0 {
+		t.Errorf("ToPNG failed: %v", err)
+	}
+}
@@ -0,0 +1,64 @@
+package pix
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// field is one EMV TLV entry: a two-digit ID, a two-digit length and the value.
+type field struct {
+	id    string
+	value string
+}
+
+// encodeTLV serializes fields in order, skipping empty values.
+func encodeTLV(fields ...field) string {
+	var sb strings.Builder
+	for _, f := range fields {
+		if f.value == "" {
+			continue
+		}
+		fmt.Fprintf(&sb, "%s%02d%s", f.id, len(f.value), f.value)
+	}
+	return sb.String()
+}
+
+// parseTLV splits s into its top-level fields.
+func parseTLV(s string) ([]field, error) {
+	var fields []field
+	for i := 0; i < len(s); {
+		if i+4 > len(s) {
+			return nil, fmt.Errorf("truncated field header at offset %d", i)
+		}
+
+		id := s[i : i+2]
+		n, err := strconv.Atoi(s[i+2 : i+4])
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("invalid length for field %s at offset %d", id, i)
+		}
+		if i+4+n > len(s) {
+			return nil, fmt.Errorf("field %s at offset %d exceeds payload", id, i)
+		}
+
+		fields = append(fields, field{id: id, value: s[i+4 : i+4+n]})
+		i += 4 + n
+	}
+	return fields, nil
+}
+
+// crc16 computes the CRC16-CCITT (polynomial 0x1021, initial value 0xFFFF) used by BR Code.
+func crc16(data string) uint16 {
+	crc := uint16(0xFFFF)
+	for i := 0; i < len(data); i++ {
+		crc ^= uint16(data[i]) << 8
+		for b := 0; b < 8; b++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
@@ -0,0 +1,93 @@
+// Package placa generates, validates and converts Brazilian vehicle license plates
+// in both the legacy (AAA-9999) and Mercosul (AAA9A99) layouts.
+package placa
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/inovacc/toolkit/data/algorithm/random"
+)
+
+var (
+	legacyPattern   = regexp.MustCompile(`^[A-Z]{3}[0-9]{4}$`)
+	mercosulPattern = regexp.MustCompile(`^[A-Z]{3}[0-9][A-Z][0-9]{2}$`)
+)
+
+// GeneratePlaca generates a random plate in the Mercosul layout (AAA9A99).
+// By default it uses crypto/rand; pass random.WithSeed or random.WithSource for reproducible output.
+func GeneratePlaca(opts ...random.Option) string {
+	rng := random.NewRand(opts...)
+	return fmt.Sprintf("%c%c%c%d%c%d%d",
+		'A'+rng.IntN(26), 'A'+rng.IntN(26), 'A'+rng.IntN(26),
+		rng.IntN(10), 'A'+rng.IntN(26), rng.IntN(10), rng.IntN(10))
+}
+
+// GenerateLegacyPlaca generates a random plate in the legacy layout, unformatted (AAA9999).
+func GenerateLegacyPlaca(opts ...random.Option) string {
+	rng := random.NewRand(opts...)
+	return fmt.Sprintf("%c%c%c%04d", 'A'+rng.IntN(26), 'A'+rng.IntN(26), 'A'+rng.IntN(26), rng.IntN(10000))
+}
+
+// ValidatePlaca reports whether value is a plate in either layout.
+func ValidatePlaca(value string) bool {
+	return IsLegacy(value) || IsMercosul(value)
+}
+
+// IsLegacy reports whether value is a plate in the legacy layout.
+func IsLegacy(value string) bool {
+	return legacyPattern.MatchString(UnformatPlaca(value))
+}
+
+// IsMercosul reports whether value is a plate in the Mercosul layout.
+func IsMercosul(value string) bool {
+	return mercosulPattern.MatchString(UnformatPlaca(value))
+}
+
+// ToMercosul converts a legacy plate to the Mercosul layout by replacing the
+// second digit with a letter (0 → A, 1 → B, ..., 9 → J). Mercosul plates are returned unchanged.
+func ToMercosul(value string) (string, error) {
+	placa := UnformatPlaca(value)
+	switch {
+	case mercosulPattern.MatchString(placa):
+		return placa, nil
+	case legacyPattern.MatchString(placa):
+		return placa[:4] + string('A'+placa[4]-'0') + placa[5:], nil
+	}
+	return "", fmt.Errorf("invalid plate: %q", value)
+}
+
+// ToLegacy converts a Mercosul plate back to the legacy layout. Only letters A to J
+// have a legacy equivalent. Legacy plates are returned unchanged.
+func ToLegacy(value string) (string, error) {
+	placa := UnformatPlaca(value)
+	switch {
+	case legacyPattern.MatchString(placa):
+		return placa, nil
+	case mercosulPattern.MatchString(placa):
+		if placa[4] > 'J' {
+			return "", fmt.Errorf("plate %q has no legacy equivalent", placa)
+		}
+		return placa[:4] + string('0'+placa[4]-'A') + placa[5:], nil
+	}
+	return "", fmt.Errorf("invalid plate: %q", value)
+}
+
+// FormatPlaca formats a plate for display: AAA-9999 for legacy plates and AAA9A99 for Mercosul plates.
+func FormatPlaca(placa string) string {
+	placa = UnformatPlaca(placa)
+	switch {
+	case legacyPattern.MatchString(placa):
+		return placa[:3] + "-" + placa[3:]
+	case mercosulPattern.MatchString(placa):
+		return placa
+	}
+	return "Invalid Placa"
+}
+
+// UnformatPlaca removes all non-alphanumeric characters from the plate and uppercases it.
+func UnformatPlaca(placa string) string {
+	re := regexp.MustCompile(`[^0-9A-Z]`)
+	return re.ReplaceAllString(strings.ToUpper(placa), "")
+}
@@ -0,0 +1,66 @@
+package placa
+
+import (
+	"testing"
+
+	"github.com/inovacc/toolkit/data/algorithm/random"
+)
+
+func TestGeneratePlaca(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		if v := GeneratePlaca(); !IsMercosul(v) {
+			t.Errorf("Invalid Mercosul plate: %s", v)
+			return
+		}
+		if v := GenerateLegacyPlaca(); !IsLegacy(v) {
+			t.Errorf("Invalid legacy plate: %s", v)
+			return
+		}
+	}
+}
+
+func TestValidatePlaca(t *testing.T) {
+	for _, v := range []string{"ABC-1234", "abc1234", "BRA2E19"} {
+		if !ValidatePlaca(v) {
+			t.Errorf("Invalid plate: %s", v)
+		}
+	}
+
+	for _, v := range []string{"AB-1234", "ABC12345", "1BC1234", "BRA2E1A"} {
+		if ValidatePlaca(v) {
+			t.Errorf("Should be invalid plate: %s", v)
+		}
+	}
+}
+
+func TestConvertPlaca(t *testing.T) {
+	v, err := ToMercosul("ABC-1234")
+	if err != nil || v != "ABC1C34" {
+		t.Errorf("ToMercosul = %s, %v", v, err)
+	}
+
+	v, err = ToLegacy(v)
+	if err != nil || v != "ABC1234" {
+		t.Errorf("ToLegacy = %s, %v", v, err)
+	}
+
+	if _, err := ToLegacy("ABC1K34"); err == nil {
+		t.Error("Expected error for plate without legacy equivalent")
+	}
+}
+
+func TestFormatPlaca(t *testing.T) {
+	if v := FormatPlaca("abc1234"); v != "ABC-1234" {
+		t.Errorf("Incorrectly formatted plate: %s", v)
+	}
+
+	if v := FormatPlaca("bra2e19"); v != "BRA2E19" {
+		t.Errorf("Incorrectly formatted plate: %s", v)
+	}
+}
+
+func TestGeneratePlacaSeeded(t *testing.T) {
+	if a, b := GeneratePlaca(random.WithSeed(42)), GeneratePlaca(random.WithSeed(42)); a != b {
+		t.Errorf("same seed produced %s and %s", a, b)
+	}
+}
@@ -0,0 +1,70 @@
+// Package renavam generates and validates RENAVAM (Registro Nacional de Veículos Automotores) codes.
+package renavam
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/inovacc/toolkit/data/algorithm/random"
+)
+
+var weights = []int{3, 2, 9, 8, 7, 6, 5, 4, 3, 2}
+
+// GenerateRENAVAM generates a random, valid RENAVAM (11 digits).
+// By default it uses crypto/rand; pass random.WithSeed or random.WithSource for reproducible output.
+func GenerateRENAVAM(opts ...random.Option) string {
+	rng := random.NewRand(opts...)
+	var sb strings.Builder
+
+	for i := 0; i < 10; i++ {
+		sb.WriteByte(byte('0' + rng.IntN(10)))
+	}
+
+	base := sb.String()
+	return fmt.Sprintf("%s%d", base, calculateDigit(base))
+}
+
+// ValidateRENAVAM verifies if a given RENAVAM is syntactically valid.
+// Legacy 9-digit codes are left-padded with zeros before checking.
+func ValidateRENAVAM(value string) bool {
+	renavam := UnformatRENAVAM(value)
+	if len(renavam) == 9 {
+		renavam = "00" + renavam
+	}
+	if len(renavam) != 11 || strings.Count(renavam, renavam[:1]) == 11 {
+		return false
+	}
+	return renavam[10] == byte('0'+calculateDigit(renavam[:10]))
+}
+
+// FormatRENAVAM returns the RENAVAM as 11 digits, left-padding legacy 9-digit codes.
+func FormatRENAVAM(renavam string) string {
+	renavam = UnformatRENAVAM(renavam)
+	if len(renavam) == 9 {
+		renavam = "00" + renavam
+	}
+	if len(renavam) != 11 {
+		return "Invalid RENAVAM"
+	}
+	return renavam
+}
+
+// UnformatRENAVAM removes all non-numeric characters from the RENAVAM string.
+func UnformatRENAVAM(renavam string) string {
+	re := regexp.MustCompile(`[^0-9]`)
+	return re.ReplaceAllString(renavam, "")
+}
+
+// calculateDigit computes the check digit: (sum * 10) modulo 11, where 10 becomes 0.
+func calculateDigit(base string) int {
+	sum := 0
+	for i, w := range weights {
+		sum += int(base[i]-'0') * w
+	}
+	dv := (sum * 10) % 11
+	if dv == 10 {
+		return 0
+	}
+	return dv
+}
@@ -0,0 +1,43 @@
+package renavam
+
+import (
+	"testing"
+
+	"github.com/inovacc/toolkit/data/algorithm/random"
+)
+
+func TestGenerateRENAVAM(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		v := GenerateRENAVAM()
+		if len(v) != 11 || !ValidateRENAVAM(v) {
+			t.Errorf("Invalid RENAVAM: %s", v)
+			return
+		}
+	}
+}
+
+func TestValidateRENAVAM(t *testing.T) {
+	for _, v := range []string{"00639741614", "639741614", "01234567897"} {
+		if !ValidateRENAVAM(v) {
+			t.Errorf("Invalid RENAVAM: %s", v)
+		}
+	}
+
+	for _, v := range []string{"00639741615", "1234"} {
+		if ValidateRENAVAM(v) {
+			t.Errorf("Should be invalid RENAVAM: %s", v)
+		}
+	}
+}
+
+func TestFormatRENAVAM(t *testing.T) {
+	if v := FormatRENAVAM("639741614"); v != "00639741614" {
+		t.Errorf("Incorrectly formatted RENAVAM: %s", v)
+	}
+}
+
+func TestGenerateRENAVAMSeeded(t *testing.T) {
+	if a, b := GenerateRENAVAM(random.WithSeed(42)), GenerateRENAVAM(random.WithSeed(42)); a != b {
+		t.Errorf("same seed produced %s and %s", a, b)
+	}
+}
@@ -0,0 +1,71 @@
+// Package rg generates and validates RG (Registro Geral) numbers following the
+// São Paulo (SSP-SP) check digit rules, the most widely used RG layout.
+package rg
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/inovacc/toolkit/data/algorithm/random"
+)
+
+// GenerateRG generates a random, valid SP RG in unformatted form (8 digits plus check digit, which may be 'X').
+// By default it uses crypto/rand; pass random.WithSeed or random.WithSource for reproducible output.
+func GenerateRG(opts ...random.Option) string {
+	rng := random.NewRand(opts...)
+	var sb strings.Builder
+
+	for i := 0; i < 8; i++ {
+		sb.WriteByte(byte('0' + rng.IntN(10)))
+	}
+
+	base := sb.String()
+	return base + calculateDigit(base)
+}
+
+// ValidateRG verifies if a given SP RG is syntactically valid.
+func ValidateRG(value string) bool {
+	rg := UnformatRG(value)
+	if len(rg) != 9 || strings.Count(rg, rg[:1]) == 9 {
+		return false
+	}
+	for i := 0; i < 8; i++ {
+		if rg[i] < '0' || rg[i] > '9' {
+			return false
+		}
+	}
+	return calculateDigit(rg[:8]) == rg[8:]
+}
+
+// FormatRG takes an RG string (with or without formatting) and returns it in the formatted style: XX.XXX.XXX-X
+func FormatRG(rg string) string {
+	rg = UnformatRG(rg)
+	if len(rg) != 9 {
+		return "Invalid RG"
+	}
+	return rg[:2] + "." + rg[2:5] + "." + rg[5:8] + "-" + rg[8:]
+}
+
+// UnformatRG removes all characters other than digits and the 'X' check digit, uppercasing it.
+func UnformatRG(rg string) string {
+	re := regexp.MustCompile(`[^0-9X]`)
+	return re.ReplaceAllString(strings.ToUpper(rg), "")
+}
+
+// calculateDigit computes the check digit: digits weighted 2 to 9, modulo 11,
+// where 10 is written as 'X' and 11 as '0'.
+func calculateDigit(base string) string {
+	sum := 0
+	for i := 0; i < 8; i++ {
+		sum += int(base[i]-'0') * (i + 2)
+	}
+	switch dv := 11 - sum%11; dv {
+	case 10:
+		return "X"
+	case 11:
+		return "0"
+	default:
+		return strconv.Itoa(dv)
+	}
+}
@@ -0,0 +1,46 @@
+package rg
+
+import (
+	"testing"
+
+	"github.com/inovacc/toolkit/data/algorithm/random"
+)
+
+func TestGenerateRG(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		v := GenerateRG()
+		if len(v) != 9 || !ValidateRG(v) {
+			t.Fatalf("Invalid RG: %s", v)
+		}
+	}
+
+	if GenerateRG(random.WithSeed(1)) != GenerateRG(random.WithSeed(1)) {
+		t.Error("same seed produced different RGs")
+	}
+}
+
+func TestValidateRG(t *testing.T) {
+	valid := []string{"24.678.131-2", "39053528X", "10.000.006-x"}
+	for _, v := range valid {
+		if !ValidateRG(v) {
+			t.Errorf("Expected valid RG: %s", v)
+		}
+	}
+
+	invalid := []string{"24.678.131-4", "11111111-1", "1234567", ""}
+	for _, v := range invalid {
+		if ValidateRG(v) {
+			t.Errorf("Should be invalid RG: %s", v)
+		}
+	}
+}
+
+func TestFormatRG(t *testing.T) {
+	if v := FormatRG("246781312"); v != "24.678.131-2" {
+		t.Errorf("Incorrectly formatted RG: %s", v)
+	}
+
+	if v := UnformatRG("10.000.009-x"); v != "10000009X" {
+		t.Errorf("Incorrectly unformatted RG: %s", v)
+	}
+}
@@ -0,0 +1,116 @@
+// Package titulo generates and validates Título de Eleitor (voter registration) numbers.
+//
+// A título has 12 digits: an 8-digit sequence, a 2-digit code for the state (UF)
+// where it was issued, and two check digits.
+package titulo
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/inovacc/toolkit/data/algorithm/random"
+)
+
+// ufCodes maps the two-digit code embedded in a título to its state. "ZZ" is used for voters abroad.
+var ufCodes = map[string]string{
+	"01": "SP", "02": "MG", "03": "RJ", "04": "RS", "05": "BA", "06": "PR", "07": "CE",
+	"08": "PE", "09": "SC", "10": "GO", "11": "MA", "12": "PB", "13": "PA", "14": "ES",
+	"15": "PI", "16": "RN", "17": "AL", "18": "MT", "19": "MS", "20": "DF", "21": "SE",
+	"22": "AM", "23": "RO", "24": "AC", "25": "AP", "26": "RR", "27": "TO", "28": "ZZ",
+}
+
+// GenerateTitulo generates a random, valid título de eleitor for any state (12 digits).
+// By default it uses crypto/rand; pass random.WithSeed or random.WithSource for reproducible output.
+func GenerateTitulo(opts ...random.Option) string {
+	rng := random.NewRand(opts...)
+	return generate(rng.IntN, fmt.Sprintf("%02d", rng.IntN(len(ufCodes))+1))
+}
+
+// GenerateTituloForUF generates a random, valid título de eleitor issued in the given state.
+func GenerateTituloForUF(uf string, opts ...random.Option) (string, error) {
+	uf = strings.ToUpper(strings.TrimSpace(uf))
+	for code, name := range ufCodes {
+		if name == uf {
+			return generate(random.NewRand(opts...).IntN, code), nil
+		}
+	}
+	return "", fmt.Errorf("unknown UF: %q", uf)
+}
+
+// ValidateTitulo verifies if a given título de eleitor is syntactically valid.
+func ValidateTitulo(value string) bool {
+	titulo := UnformatTitulo(value)
+	if len(titulo) != 12 {
+		return false
+	}
+	if _, ok := ufCodes[titulo[8:10]]; !ok {
+		return false
+	}
+
+	dv1, dv2 := calculateDigits(titulo[:8], titulo[8:10])
+	return titulo[10] == byte('0'+dv1) && titulo[11] == byte('0'+dv2)
+}
+
+// UF returns the state encoded in a título de eleitor, or an error if the code is unknown.
+func UF(value string) (string, error) {
+	titulo := UnformatTitulo(value)
+	if len(titulo) != 12 {
+		return "", fmt.Errorf("invalid título length: %d", len(titulo))
+	}
+	uf, ok := ufCodes[titulo[8:10]]
+	if !ok {
+		return "", fmt.Errorf("unknown UF code: %s", titulo[8:10])
+	}
+	return uf, nil
+}
+
+// FormatTitulo takes a título string (with or without formatting) and returns it in the formatted style: XXXX XXXX XXXX
+func FormatTitulo(titulo string) string {
+	titulo = UnformatTitulo(titulo)
+	if len(titulo) != 12 {
+		return "Invalid Titulo"
+	}
+	return titulo[:4] + " " + titulo[4:8] + " " + titulo[8:]
+}
+
+// UnformatTitulo removes all non-numeric characters from the título string.
+func UnformatTitulo(titulo string) string {
+	re := regexp.MustCompile(`[^0-9]`)
+	return re.ReplaceAllString(titulo, "")
+}
+
+func generate(intN func(int) int, uf string) string {
+	var sb strings.Builder
+	for i := 0; i < 8; i++ {
+		sb.WriteByte(byte('0' + intN(10)))
+	}
+
+	seq := sb.String()
+	dv1, dv2 := calculateDigits(seq, uf)
+	return fmt.Sprintf("%s%s%d%d", seq, uf, dv1, dv2)
+}
+
+// calculateDigits computes both check digits. São Paulo and Minas Gerais
+// use 1 instead of 0 when the remainder is zero.
+func calculateDigits(seq, uf string) (int, int) {
+	sum := 0
+	for i := 0; i < 8; i++ {
+		sum += int(seq[i]-'0') * (i + 2)
+	}
+	dv1 := adjust(sum%11, uf)
+
+	sum = int(uf[0]-'0')*7 + int(uf[1]-'0')*8 + dv1*9
+	dv2 := adjust(sum%11, uf)
+	return dv1, dv2
+}
+
+func adjust(rest int, uf string) int {
+	switch {
+	case rest == 10:
+		return 0
+	case rest == 0 && (uf == "01" || uf == "02"):
+		return 1
+	}
+	return rest
+}
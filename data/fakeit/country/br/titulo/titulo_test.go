@@ -0,0 +1,63 @@
+package titulo
+
+import (
+	"testing"
+
+	"github.com/inovacc/toolkit/data/algorithm/random"
+)
+
+func TestGenerateTitulo(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		v := GenerateTitulo()
+		if len(v) != 12 || !ValidateTitulo(v) {
+			t.Errorf("Invalid titulo: %s", v)
+			return
+		}
+	}
+
+	v, err := GenerateTituloForUF("rj")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if uf, _ := UF(v); uf != "RJ" || !ValidateTitulo(v) {
+		t.Errorf("Invalid RJ titulo: %s", v)
+	}
+
+	if _, err := GenerateTituloForUF("XX"); err == nil {
+		t.Error("Expected error for unknown UF")
+	}
+}
+
+func TestValidateTitulo(t *testing.T) {
+	cases := map[string]string{
+		"102356870141":   "SP",
+		"0043 2111 2810": "ZZ",
+		"456123782046":   "DF",
+	}
+	for v, want := range cases {
+		if !ValidateTitulo(v) {
+			t.Errorf("Invalid titulo: %s", v)
+		}
+		if uf, err := UF(v); err != nil || uf != want {
+			t.Errorf("UF(%s) = %s, %v; want %s", v, uf, err, want)
+		}
+	}
+
+	for _, v := range []string{"102356870140", "102356872941", "1023"} {
+		if ValidateTitulo(v) {
+			t.Errorf("Should be invalid titulo: %s", v)
+		}
+	}
+}
+
+func TestFormatTitulo(t *testing.T) {
+	if v := FormatTitulo("102356870141"); v != "1023 5687 0141" {
+		t.Errorf("Incorrectly formatted titulo: %s", v)
+	}
+}
+
+func TestGenerateTituloSeeded(t *testing.T) {
+	if a, b := GenerateTitulo(random.WithSeed(42)), GenerateTitulo(random.WithSeed(42)); a != b {
+		t.Errorf("same seed produced %s and %s", a, b)
+	}
+}
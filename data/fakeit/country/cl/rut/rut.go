@@ -0,0 +1,77 @@
+// Package rut generates and validates Chilean RUT/RUN (Rol Único Tributario/Nacional) numbers.
+package rut
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/inovacc/toolkit/data/algorithm/random"
+)
+
+// GenerateRUT generates a random, valid RUT in unformatted form (body followed by the verifier, e.g. "123456785").
+// By default it uses crypto/rand; pass random.WithSeed or random.WithSource for reproducible output.
+func GenerateRUT(opts ...random.Option) string {
+	body := 1000000 + random.NewRand(opts...).IntN(24000000)
+	return fmt.Sprintf("%d%c", body, calculateVerifier(body))
+}
+
+// ValidateRUT verifies if a given RUT is syntactically valid. The verifier may be a digit or K.
+func ValidateRUT(value string) bool {
+	rut := UnformatRUT(value)
+	if len(rut) < 2 || len(rut) > 9 {
+		return false
+	}
+
+	body, err := strconv.Atoi(rut[:len(rut)-1])
+	if err != nil || body <= 0 {
+		return false
+	}
+	return rut[len(rut)-1] == calculateVerifier(body)
+}
+
+// FormatRUT takes a RUT string (with or without formatting) and returns it in the formatted style: XX.XXX.XXX-V
+func FormatRUT(rut string) string {
+	rut = UnformatRUT(rut)
+	if len(rut) < 2 || len(rut) > 9 {
+		return "Invalid RUT"
+	}
+
+	body := rut[:len(rut)-1]
+	var groups []string
+	for len(body) > 3 {
+		groups = append([]string{body[len(body)-3:]}, groups...)
+		body = body[:len(body)-3]
+	}
+	groups = append([]string{body}, groups...)
+
+	return strings.Join(groups, ".") + "-" + rut[len(rut)-1:]
+}
+
+// UnformatRUT removes dots, dashes and spaces from the RUT string, uppercases a K verifier
+// and drops leading zeros.
+func UnformatRUT(rut string) string {
+	re := regexp.MustCompile(`[^0-9K]`)
+	return strings.TrimLeft(re.ReplaceAllString(strings.ToUpper(rut), ""), "0")
+}
+
+// calculateVerifier computes the modulo 11 verifier with weights 2 to 7 from the right.
+func calculateVerifier(body int) byte {
+	sum, w := 0, 2
+	for ; body > 0; body /= 10 {
+		sum += body % 10 * w
+		if w++; w > 7 {
+			w = 2
+		}
+	}
+
+	switch dv := 11 - sum%11; dv {
+	case 11:
+		return '0'
+	case 10:
+		return 'K'
+	default:
+		return byte('0' + dv)
+	}
+}
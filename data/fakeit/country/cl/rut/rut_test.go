@@ -0,0 +1,21 @@
+package rut
+
+import "testing"
+
+func TestVerifierK(t *testing.T) {
+	// A remainder of 10 yields the letter K, accepted in either case
+	for _, v := range []string{"1.000.005-K", "1000005k"} {
+		if !ValidateRUT(v) {
+			t.Errorf("Invalid RUT: %s", v)
+		}
+	}
+
+	if v := FormatRUT("1000005k"); v != "1.000.005-K" {
+		t.Errorf("Incorrectly formatted RUT: %s", v)
+	}
+
+	// Leading zeros are not part of the body
+	if v := UnformatRUT("00.012.345.678-5"); v != "123456785" {
+		t.Errorf("Incorrectly unformatted RUT: %s", v)
+	}
+}
@@ -0,0 +1,69 @@
+// Package nit generates and validates Colombian NIT (Número de Identificación Tributaria) numbers.
+package nit
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/inovacc/toolkit/data/algorithm/random"
+)
+
+// weights are the DIAN prime weights, applied from the rightmost digit of the base.
+var weights = []int{3, 7, 13, 17, 19, 23, 29, 37, 41, 43, 47, 53, 59, 67, 71}
+
+// GenerateNIT generates a random, valid company NIT in unformatted form (9-digit base starting with 8 or 9, plus the verifier).
+// By default it uses crypto/rand; pass random.WithSeed or random.WithSource for reproducible output.
+func GenerateNIT(opts ...random.Option) string {
+	rng := random.NewRand(opts...)
+	base := fmt.Sprintf("%d%08d", 8+rng.IntN(2), rng.IntN(100000000))
+	return fmt.Sprintf("%s%d", base, calculateDigit(base))
+}
+
+// ValidateNIT verifies if a given NIT, including its verification digit, is syntactically valid.
+func ValidateNIT(value string) bool {
+	nit := UnformatNIT(value)
+	if len(nit) < 2 || len(nit) > len(weights)+1 || strings.Trim(nit, "0") == "" {
+		return false
+	}
+
+	base := nit[:len(nit)-1]
+	return nit[len(nit)-1] == byte('0'+calculateDigit(base))
+}
+
+// FormatNIT takes a NIT string (with or without formatting) and returns it in the formatted style: XXX.XXX.XXX-V
+func FormatNIT(nit string) string {
+	nit = UnformatNIT(nit)
+	if len(nit) < 2 {
+		return "Invalid NIT"
+	}
+
+	base := nit[:len(nit)-1]
+	var groups []string
+	for len(base) > 3 {
+		groups = append([]string{base[len(base)-3:]}, groups...)
+		base = base[:len(base)-3]
+	}
+	groups = append([]string{base}, groups...)
+
+	return strings.Join(groups, ".") + "-" + nit[len(nit)-1:]
+}
+
+// UnformatNIT removes all non-numeric characters from the NIT string.
+func UnformatNIT(nit string) string {
+	re := regexp.MustCompile(`[^0-9]`)
+	return re.ReplaceAllString(nit, "")
+}
+
+// calculateDigit computes the DIAN modulo 11 verification digit.
+func calculateDigit(base string) int {
+	sum := 0
+	for i := 0; i < len(base); i++ {
+		sum += int(base[len(base)-1-i]-'0') * weights[i]
+	}
+	r := sum % 11
+	if r >= 2 {
+		return 11 - r
+	}
+	return r
+}
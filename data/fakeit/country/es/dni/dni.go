@@ -0,0 +1,56 @@
+// Package dni generates and validates Spanish DNI (Documento Nacional de Identidad) numbers.
+package dni
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/inovacc/toolkit/data/algorithm/random"
+)
+
+// Letters maps the remainder of the number modulo 23 to the control letter.
+// It is shared with the NIE, which uses the same algorithm.
+const Letters = "TRWAGMYFPDXBNJZSQVHLCKE"
+
+// ControlLetter returns the control letter for the numeric part of a DNI or NIE.
+func ControlLetter(number int) byte {
+	return Letters[number%23]
+}
+
+// GenerateDNI generates a random, valid DNI in unformatted form (8 digits and a letter).
+// By default it uses crypto/rand; pass random.WithSeed or random.WithSource for reproducible output.
+func GenerateDNI(opts ...random.Option) string {
+	n := random.NewRand(opts...).IntN(100000000)
+	return fmt.Sprintf("%08d%c", n, ControlLetter(n))
+}
+
+// ValidateDNI verifies if a given DNI has 8 digits followed by the right control letter.
+func ValidateDNI(value string) bool {
+	dni := UnformatDNI(value)
+	if len(dni) != 9 {
+		return false
+	}
+
+	n, err := strconv.Atoi(dni[:8])
+	if err != nil {
+		return false
+	}
+	return dni[8] == ControlLetter(n)
+}
+
+// FormatDNI takes a DNI string (with or without formatting) and returns it in the formatted style: XXXXXXXX-L
+func FormatDNI(dni string) string {
+	dni = UnformatDNI(dni)
+	if len(dni) != 9 {
+		return "Invalid DNI"
+	}
+	return dni[:8] + "-" + dni[8:]
+}
+
+// UnformatDNI removes all non-alphanumeric characters from the DNI string and uppercases the letter.
+func UnformatDNI(dni string) string {
+	re := regexp.MustCompile(`[^0-9A-Z]`)
+	return re.ReplaceAllString(strings.ToUpper(dni), "")
+}
@@ -0,0 +1,12 @@
+package dni
+
+import "testing"
+
+func TestControlLetter(t *testing.T) {
+	// The letter cycles with the remainder modulo 23, and is shared with the NIE
+	for n, want := range map[int]byte{0: 'T', 12345678: 'Z', 22: 'E', 23: 'T'} {
+		if got := ControlLetter(n); got != want {
+			t.Errorf("ControlLetter(%d) = %c, want %c", n, got, want)
+		}
+	}
+}
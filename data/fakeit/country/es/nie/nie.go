@@ -0,0 +1,52 @@
+// Package nie generates and validates Spanish NIE (Número de Identidad de Extranjero) numbers.
+package nie
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/inovacc/toolkit/data/algorithm/random"
+	"github.com/inovacc/toolkit/data/fakeit/country/es/dni"
+)
+
+// GenerateNIE generates a random, valid NIE in unformatted form (X, Y or Z, 7 digits and a letter).
+// By default it uses crypto/rand; pass random.WithSeed or random.WithSource for reproducible output.
+func GenerateNIE(opts ...random.Option) string {
+	rng := random.NewRand(opts...)
+	prefix := rng.IntN(3)
+	n := rng.IntN(10000000)
+	return fmt.Sprintf("%c%07d%c", "XYZ"[prefix], n, dni.ControlLetter(prefix*10000000+n))
+}
+
+// ValidateNIE verifies if a given NIE is syntactically valid. The leading X, Y or Z
+// stands for 0, 1 or 2 when computing the control letter.
+func ValidateNIE(value string) bool {
+	nie := UnformatNIE(value)
+	if len(nie) != 9 {
+		return false
+	}
+
+	prefix := strings.IndexByte("XYZ", nie[0])
+	n, err := strconv.Atoi(nie[1:8])
+	if prefix < 0 || err != nil {
+		return false
+	}
+	return nie[8] == dni.ControlLetter(prefix*10000000+n)
+}
+
+// FormatNIE takes a NIE string (with or without formatting) and returns it in the formatted style: L-XXXXXXX-L
+func FormatNIE(nie string) string {
+	nie = UnformatNIE(nie)
+	if len(nie) != 9 {
+		return "Invalid NIE"
+	}
+	return nie[:1] + "-" + nie[1:8] + "-" + nie[8:]
+}
+
+// UnformatNIE removes all non-alphanumeric characters from the NIE string and uppercases the letters.
+func UnformatNIE(nie string) string {
+	re := regexp.MustCompile(`[^0-9A-Z]`)
+	return re.ReplaceAllString(strings.ToUpper(nie), "")
+}
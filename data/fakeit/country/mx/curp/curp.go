@@ -0,0 +1,96 @@
+// Package curp generates and validates Mexican CURP (Clave Única de Registro de Población) codes.
+package curp
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/inovacc/toolkit/data/algorithm/random"
+)
+
+// charset maps each character to its value in the RENAPO check digit table.
+// Ñ is the only multi-byte character, so values are rune positions.
+var charset = []rune("0123456789ABCDEFGHIJKLMNÑOPQRSTUVWXYZ")
+
+// states lists the two-letter birth state codes; NE is used for people born abroad.
+var states = []string{
+	"AS", "BC", "BS", "CC", "CL", "CM", "CS", "CH", "DF", "DG", "GT", "GR", "HG", "JC", "MC", "MN", "MS",
+	"NT", "NL", "OC", "PL", "QT", "QR", "SP", "SL", "SR", "TC", "TS", "TL", "VZ", "YN", "ZS", "NE",
+}
+
+var pattern = regexp.MustCompile(`^[A-Z][AEIOUX][A-Z]{2}[0-9]{6}[HMX][A-Z]{2}[B-DF-HJ-NP-TV-Z]{3}[0-9A-Z][0-9]$`)
+
+// GenerateCURP generates a random, valid CURP.
+// By default it uses crypto/rand; pass random.WithSeed or random.WithSource for reproducible output.
+func GenerateCURP(opts ...random.Option) string {
+	rng := random.NewRand(opts...)
+	const (
+		letters    = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+		vowels     = "AEIOU"
+		consonants = "BCDFGHJKLMNPQRSTVWXYZ"
+	)
+
+	birth := time.Date(1950, 1, 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, rng.IntN(365*70))
+
+	var sb strings.Builder
+	sb.WriteByte(letters[rng.IntN(len(letters))])
+	sb.WriteByte(vowels[rng.IntN(len(vowels))])
+	sb.WriteByte(letters[rng.IntN(len(letters))])
+	sb.WriteByte(letters[rng.IntN(len(letters))])
+	sb.WriteString(birth.Format("060102"))
+	sb.WriteByte("HM"[rng.IntN(2)])
+	sb.WriteString(states[rng.IntN(len(states))])
+	for i := 0; i < 3; i++ {
+		sb.WriteByte(consonants[rng.IntN(len(consonants))])
+	}
+
+	// The 17th character distinguishes the century: a digit before 2000, a letter from 2000 on
+	if birth.Year() < 2000 {
+		sb.WriteByte(byte('0' + rng.IntN(10)))
+	} else {
+		sb.WriteByte(letters[rng.IntN(len(letters))])
+	}
+
+	base := sb.String()
+	return fmt.Sprintf("%s%d", base, calculateDigit(base))
+}
+
+// ValidateCURP verifies if a given CURP is syntactically valid: the layout, birth date,
+// state code and check digit.
+func ValidateCURP(value string) bool {
+	curp := UnformatCURP(value)
+	if !pattern.MatchString(curp) || !slices.Contains(states, curp[11:13]) {
+		return false
+	}
+	if _, err := time.Parse("060102", curp[4:10]); err != nil {
+		return false
+	}
+	return curp[17] == byte('0'+calculateDigit(curp[:17]))
+}
+
+// FormatCURP returns the CURP in its canonical form: 18 uppercase characters without separators.
+func FormatCURP(curp string) string {
+	curp = UnformatCURP(curp)
+	if len(curp) != 18 {
+		return "Invalid CURP"
+	}
+	return curp
+}
+
+// UnformatCURP removes all non-alphanumeric characters from the CURP string and uppercases it.
+func UnformatCURP(curp string) string {
+	re := regexp.MustCompile(`[^0-9A-Z]`)
+	return re.ReplaceAllString(strings.ToUpper(curp), "")
+}
+
+// calculateDigit computes the check digit: weights 18 down to 2, then 10 minus the remainder modulo 10.
+func calculateDigit(base string) int {
+	sum := 0
+	for i, r := range base {
+		sum += slices.Index(charset, r) * (18 - i)
+	}
+	return (10 - sum%10) % 10
+}
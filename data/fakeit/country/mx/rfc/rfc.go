@@ -0,0 +1,110 @@
+// Package rfc generates and validates Mexican RFC (Registro Federal de Contribuyentes) codes
+// for individuals (13 characters) and companies (12 characters).
+package rfc
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/inovacc/toolkit/data/algorithm/random"
+)
+
+// charset maps each character to its value in the SAT check digit table.
+// Ñ is the only multi-byte character and comes last, so byte offsets equal the values.
+const charset = "0123456789ABCDEFGHIJKLMN&OPQRSTUVWXYZ Ñ"
+
+var pattern = regexp.MustCompile(`^[A-ZÑ&]{3,4}[0-9]{6}[A-Z0-9]{2}[0-9A]$`)
+
+// GenerateRFC generates a random, valid RFC for an individual (persona física).
+// By default it uses crypto/rand; pass random.WithSeed or random.WithSource for reproducible output.
+func GenerateRFC(opts ...random.Option) string {
+	return generate(4, opts...)
+}
+
+// GenerateCompanyRFC generates a random, valid RFC for a company (persona moral).
+func GenerateCompanyRFC(opts ...random.Option) string {
+	return generate(3, opts...)
+}
+
+func generate(letters int, opts ...random.Option) string {
+	rng := random.NewRand(opts...)
+	var sb strings.Builder
+
+	for i := 0; i < letters; i++ {
+		sb.WriteByte(byte('A' + rng.IntN(26)))
+	}
+
+	date := time.Date(1950, 1, 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, rng.IntN(365*55))
+	sb.WriteString(date.Format("060102"))
+
+	const homoclave = "123456789ABCDEFGHIJKLMNPQRSTUVWXYZ"
+	sb.WriteByte(homoclave[rng.IntN(len(homoclave))])
+	sb.WriteByte(homoclave[rng.IntN(len(homoclave))])
+
+	base := sb.String()
+	return base + string(calculateDigit(base))
+}
+
+// ValidateRFC verifies if a given RFC, for an individual or a company, is syntactically valid:
+// the layout, the embedded date and the check digit.
+func ValidateRFC(value string) bool {
+	rfc := UnformatRFC(value)
+	if !pattern.MatchString(rfc) {
+		return false
+	}
+
+	runes := []rune(rfc)
+	date := string(runes[len(runes)-9 : len(runes)-3])
+	if _, err := time.Parse("060102", date); err != nil {
+		return false
+	}
+
+	base := string(runes[:len(runes)-1])
+	return runes[len(runes)-1] == calculateDigit(base)
+}
+
+// IsCompany reports whether the RFC belongs to a company (12 characters).
+func IsCompany(value string) bool {
+	return len([]rune(UnformatRFC(value))) == 12
+}
+
+// FormatRFC takes an RFC string (with or without formatting) and returns it in the formatted style: AAAA-YYMMDD-XXX
+func FormatRFC(rfc string) string {
+	runes := []rune(UnformatRFC(rfc))
+	if len(runes) != 12 && len(runes) != 13 {
+		return "Invalid RFC"
+	}
+
+	n := len(runes)
+	return string(runes[:n-9]) + "-" + string(runes[n-9:n-3]) + "-" + string(runes[n-3:])
+}
+
+// UnformatRFC removes spaces and dashes from the RFC string and uppercases it.
+func UnformatRFC(rfc string) string {
+	re := regexp.MustCompile(`[^0-9A-ZÑ&]`)
+	return re.ReplaceAllString(strings.ToUpper(rfc), "")
+}
+
+// calculateDigit computes the SAT modulo 11 check digit over the first 11 or 12 characters.
+// Company RFCs are left-padded with a space so both kinds use the same 12 weights.
+func calculateDigit(base string) rune {
+	runes := []rune(base)
+	if len(runes) == 11 {
+		runes = append([]rune{' '}, runes...)
+	}
+
+	sum := 0
+	for i, r := range runes {
+		sum += strings.IndexRune(charset, r) * (13 - i)
+	}
+
+	switch dv := 11 - sum%11; dv {
+	case 11:
+		return '0'
+	case 10:
+		return 'A'
+	default:
+		return rune('0' + dv)
+	}
+}
@@ -0,0 +1,27 @@
+package rfc
+
+import (
+	"testing"
+
+	"github.com/inovacc/toolkit/data/algorithm/random"
+)
+
+func TestCompanyRFC(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		v := GenerateCompanyRFC()
+		if !ValidateRFC(v) || !IsCompany(v) {
+			t.Fatalf("Invalid company RFC: %s", v)
+		}
+		if f := FormatRFC(v); len(f) != 14 || UnformatRFC(f) != v {
+			t.Fatalf("Incorrectly formatted company RFC: %s", f)
+		}
+	}
+
+	if a, b := GenerateCompanyRFC(random.WithSeed(42)), GenerateCompanyRFC(random.WithSeed(42)); a != b {
+		t.Errorf("same seed produced %s and %s", a, b)
+	}
+
+	if IsCompany("GODE561231GR8") {
+		t.Error("individual RFC reported as a company")
+	}
+}
@@ -0,0 +1,62 @@
+// Package nif generates and validates Portuguese NIF (Número de Identificação Fiscal) numbers.
+package nif
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/inovacc/toolkit/data/algorithm/random"
+)
+
+// GenerateNIF generates a random, valid NIF for an individual (starting with 1, 2 or 3) in unformatted form.
+// By default it uses crypto/rand; pass random.WithSeed or random.WithSource for reproducible output.
+func GenerateNIF(opts ...random.Option) string {
+	rng := random.NewRand(opts...)
+	var sb strings.Builder
+
+	sb.WriteByte(byte('1' + rng.IntN(3)))
+	for i := 0; i < 7; i++ {
+		sb.WriteByte(byte('0' + rng.IntN(10)))
+	}
+
+	base := sb.String()
+	return fmt.Sprintf("%s%d", base, calculateDigit(base))
+}
+
+// ValidateNIF verifies if a given NIF is syntactically valid. The first digit
+// identifies the holder: 1-3 individuals, 5 companies, 6 public bodies, 8 sole traders and 9 other entities.
+func ValidateNIF(value string) bool {
+	nif := UnformatNIF(value)
+	if len(nif) != 9 || !strings.ContainsAny(nif[:1], "1235689") {
+		return false
+	}
+	return nif[8] == byte('0'+calculateDigit(nif[:8]))
+}
+
+// FormatNIF takes a NIF string (with or without formatting) and returns it in the formatted style: XXX XXX XXX
+func FormatNIF(nif string) string {
+	nif = UnformatNIF(nif)
+	if len(nif) != 9 {
+		return "Invalid NIF"
+	}
+	return nif[:3] + " " + nif[3:6] + " " + nif[6:]
+}
+
+// UnformatNIF removes all non-numeric characters from the NIF string.
+func UnformatNIF(nif string) string {
+	re := regexp.MustCompile(`[^0-9]`)
+	return re.ReplaceAllString(nif, "")
+}
+
+// calculateDigit computes the modulo 11 check digit with weights 9 down to 2.
+func calculateDigit(base string) int {
+	sum := 0
+	for i := 0; i < 8; i++ {
+		sum += int(base[i]-'0') * (9 - i)
+	}
+	if r := sum % 11; r >= 2 {
+		return 11 - r
+	}
+	return 0
+}
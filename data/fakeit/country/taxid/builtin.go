@@ -0,0 +1,40 @@
+package taxid
+
+import (
+	"github.com/inovacc/toolkit/data/fakeit/country/ar/cuit"
+	"github.com/inovacc/toolkit/data/fakeit/country/br/cnpj"
+	"github.com/inovacc/toolkit/data/fakeit/country/br/cpf"
+	"github.com/inovacc/toolkit/data/fakeit/country/cl/rut"
+	"github.com/inovacc/toolkit/data/fakeit/country/co/nit"
+	"github.com/inovacc/toolkit/data/fakeit/country/es/dni"
+	"github.com/inovacc/toolkit/data/fakeit/country/es/nie"
+	"github.com/inovacc/toolkit/data/fakeit/country/mx/curp"
+	"github.com/inovacc/toolkit/data/fakeit/country/mx/rfc"
+	"github.com/inovacc/toolkit/data/fakeit/country/pt/nif"
+	"github.com/inovacc/toolkit/data/fakeit/country/us/ein"
+	"github.com/inovacc/toolkit/data/fakeit/country/us/ssn"
+)
+
+// builtin lists the documents implemented by the country packages of this module.
+var builtin = []TaxID{
+	New("BR", "CPF", cpf.GenerateCPF, cpf.ValidateCPF, cpf.FormatCPF, cpf.UnformatCPF),
+	New("BR", "CNPJ", cnpj.GenerateCNPJ, cnpj.ValidateCNPJ, cnpj.FormatCNPJ, cnpj.UnformatCNPJ),
+	New("US", "SSN", ssn.GenerateSSN, ssn.ValidateSSN, ssn.FormatSSN, ssn.UnformatSSN),
+	New("US", "EIN", ein.GenerateEIN, ein.ValidateEIN, ein.FormatEIN, ein.UnformatEIN),
+	New("PT", "NIF", nif.GenerateNIF, nif.ValidateNIF, nif.FormatNIF, nif.UnformatNIF),
+	New("ES", "DNI", dni.GenerateDNI, dni.ValidateDNI, dni.FormatDNI, dni.UnformatDNI),
+	New("ES", "NIE", nie.GenerateNIE, nie.ValidateNIE, nie.FormatNIE, nie.UnformatNIE),
+	New("AR", "CUIT", cuit.GenerateCUIT, cuit.ValidateCUIT, cuit.FormatCUIT, cuit.UnformatCUIT),
+	New("CL", "RUT", rut.GenerateRUT, rut.ValidateRUT, rut.FormatRUT, rut.UnformatRUT),
+	New("MX", "RFC", rfc.GenerateRFC, rfc.ValidateRFC, rfc.FormatRFC, rfc.UnformatRFC),
+	New("MX", "CURP", curp.GenerateCURP, curp.ValidateCURP, curp.FormatCURP, curp.UnformatCURP),
+	New("CO", "NIT", nit.GenerateNIT, nit.ValidateNIT, nit.FormatNIT, nit.UnformatNIT),
+}
+
+func init() {
+	for _, id := range builtin {
+		if err := Register(id); err != nil {
+			panic(err)
+		}
+	}
+}
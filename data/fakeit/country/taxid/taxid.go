@@ -0,0 +1,127 @@
+// Package taxid exposes national taxpayer and identity documents from every
+// country package behind a common interface, with a registry keyed by ISO 3166-1
+// alpha-2 country code.
+package taxid
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/inovacc/toolkit/data/algorithm/random"
+)
+
+// TaxID is implemented by every supported document type.
+type TaxID interface {
+	// Generate returns a random, valid document in unformatted form.
+	Generate(opts ...random.Option) string
+	// Validate reports whether value, formatted or not, is a valid document.
+	Validate(value string) bool
+	// Format applies the document's display mask.
+	Format(value string) string
+	// Unformat strips the display mask.
+	Unformat(value string) string
+	// Country returns the ISO 3166-1 alpha-2 code of the issuing country, e.g. "BR".
+	Country() string
+	// Kind returns the document's short name, e.g. "CPF".
+	Kind() string
+}
+
+// document adapts the Generate/Validate/Format/Unformat functions of a country package to TaxID.
+type document struct {
+	country  string
+	kind     string
+	generate func(opts ...random.Option) string
+	validate func(value string) bool
+	format   func(value string) string
+	unformat func(value string) string
+}
+
+// New builds a TaxID from the functions of a country package.
+func New(country, kind string,
+	generate func(opts ...random.Option) string,
+	validate func(value string) bool,
+	format func(value string) string,
+	unformat func(value string) string,
+) TaxID {
+	return &document{
+		country:  strings.ToUpper(country),
+		kind:     strings.ToUpper(kind),
+		generate: generate,
+		validate: validate,
+		format:   format,
+		unformat: unformat,
+	}
+}
+
+func (d *document) Generate(opts ...random.Option) string { return d.generate(opts...) }
+func (d *document) Validate(value string) bool            { return d.validate(value) }
+func (d *document) Format(value string) string            { return d.format(value) }
+func (d *document) Unformat(value string) string          { return d.unformat(value) }
+func (d *document) Country() string                       { return d.country }
+func (d *document) Kind() string                          { return d.kind }
+
+var (
+	mu       sync.RWMutex
+	registry = make(map[string][]TaxID)
+)
+
+// Register adds a TaxID to the registry. It fails if the country already has a document of the same kind.
+func Register(id TaxID) error {
+	mu.Lock()
+	defer mu.Unlock()
+
+	country := strings.ToUpper(id.Country())
+	for _, existing := range registry[country] {
+		if strings.EqualFold(existing.Kind(), id.Kind()) {
+			return fmt.Errorf("taxid: %s %s already registered", country, id.Kind())
+		}
+	}
+	registry[country] = append(registry[country], id)
+	return nil
+}
+
+// Lookup returns the document of the given kind issued by country. Both are case-insensitive.
+func Lookup(country, kind string) (TaxID, error) {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	for _, id := range registry[strings.ToUpper(country)] {
+		if strings.EqualFold(id.Kind(), kind) {
+			return id, nil
+		}
+	}
+	return nil, fmt.Errorf("taxid: unknown document %s %s", strings.ToUpper(country), strings.ToUpper(kind))
+}
+
+// ForCountry returns the documents registered for country, in registration order.
+func ForCountry(country string) []TaxID {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	return append([]TaxID(nil), registry[strings.ToUpper(country)]...)
+}
+
+// Countries returns the sorted codes of the countries with at least one registered document.
+func Countries() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	countries := make([]string, 0, len(registry))
+	for country := range registry {
+		countries = append(countries, country)
+	}
+	sort.Strings(countries)
+	return countries
+}
+
+// Detect returns the first document of country for which value is valid.
+func Detect(country, value string) (TaxID, bool) {
+	for _, id := range ForCountry(country) {
+		if id.Validate(value) {
+			return id, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,143 @@
+package taxid
+
+import (
+	"testing"
+
+	"github.com/inovacc/toolkit/data/algorithm/random"
+)
+
+func TestBuiltin(t *testing.T) {
+	for _, id := range builtin {
+		for i := 0; i < 50; i++ {
+			v := id.Generate()
+			if !id.Validate(v) {
+				t.Fatalf("%s %s: generated invalid value %q", id.Country(), id.Kind(), v)
+			}
+
+			f := id.Format(v)
+			if !id.Validate(f) || id.Unformat(f) != v {
+				t.Fatalf("%s %s: format round trip failed: %q -> %q -> %q", id.Country(), id.Kind(), v, f, id.Unformat(f))
+			}
+		}
+
+		if a, b := id.Generate(random.WithSeed(9)), id.Generate(random.WithSeed(9)); a != b {
+			t.Errorf("%s %s: same seed produced %q and %q", id.Country(), id.Kind(), a, b)
+		}
+	}
+}
+
+// vectors holds known values for the documents of the country packages, which
+// rely on this table instead of repeating the same tests in every package.
+var vectors = []struct {
+	country, kind string
+	valid         []string
+	invalid       []string
+	// raw and formatted are the same document without and with its display mask
+	raw, formatted string
+}{
+	{"US", "SSN", []string{"123-45-6789", "078051120"},
+		[]string{"000-12-3456", "666-12-3456", "900-12-3456", "123-00-4567", "123-45-0000", "1234"},
+		"123456789", "123-45-6789"},
+	{"US", "EIN", []string{"12-3456789", "981234567"},
+		[]string{"07-1234567", "00-1234567", "1234"},
+		"123456789", "12-3456789"},
+	{"PT", "NIF", []string{"123456789", "501 964 843"},
+		[]string{"123456780", "423456789", "12345"},
+		"123456789", "123 456 789"},
+	{"ES", "DNI", []string{"12345678Z", "12345678-z", "00000000T"},
+		[]string{"12345678A", "1234567Z"},
+		"12345678Z", "12345678-Z"},
+	{"ES", "NIE", []string{"X1234567L", "Y-2345678-Z"},
+		[]string{"X1234567A", "A1234567L", "X123456L"},
+		"X1234567L", "X-1234567-L"},
+	{"AR", "CUIT", []string{"20-12345678-6", "30500010912"},
+		[]string{"20-12345678-5", "11-12345678-6", "2012345678"},
+		"30500010912", "30-50001091-2"},
+	{"CL", "RUT", []string{"12.345.678-5", "76086428-5", "11111111-1"},
+		[]string{"12.345.678-K", "12345678-0", "K"},
+		"123456785", "12.345.678-5"},
+	{"MX", "RFC", []string{"GODE561231GR8", "GODE-561231-GR8"},
+		[]string{"GODE561231GR9", "GODE561341GR8", "GO561231GR8"},
+		"GODE561231GR8", "GODE-561231-GR8"},
+	{"MX", "CURP", []string{"HEGG560427MVZRRL04"},
+		[]string{"HEGG560427MVZRRL05", "HEGG560427MXXRRL04", "HEGG561327MVZRRL04"},
+		"HEGG560427MVZRRL04", "HEGG560427MVZRRL04"},
+	{"CO", "NIT", []string{"800197268-4", "900.123.456-8"},
+		[]string{"800197268-5", "0"},
+		"8001972684", "800.197.268-4"},
+}
+
+func TestVectors(t *testing.T) {
+	for _, tc := range vectors {
+		t.Run(tc.country+"/"+tc.kind, func(t *testing.T) {
+			id, err := Lookup(tc.country, tc.kind)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, v := range tc.valid {
+				if !id.Validate(v) {
+					t.Errorf("Validate(%q) = false", v)
+				}
+			}
+			for _, v := range tc.invalid {
+				if id.Validate(v) {
+					t.Errorf("Validate(%q) = true", v)
+				}
+			}
+
+			if v := id.Format(tc.raw); v != tc.formatted {
+				t.Errorf("Format(%q) = %q, want %q", tc.raw, v, tc.formatted)
+			}
+			if v := id.Unformat(tc.formatted); v != tc.raw {
+				t.Errorf("Unformat(%q) = %q, want %q", tc.formatted, v, tc.raw)
+			}
+		})
+	}
+}
+
+func TestLookup(t *testing.T) {
+	id, err := Lookup("br", "cpf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id.Country() != "BR" || id.Kind() != "CPF" {
+		t.Errorf("Lookup returned %s %s", id.Country(), id.Kind())
+	}
+
+	if _, err := Lookup("BR", "SSN"); err == nil {
+		t.Error("expected error for unknown kind")
+	}
+
+	want := []string{"AR", "BR", "CL", "CO", "ES", "MX", "PT", "US"}
+	got := Countries()
+	if len(got) != len(want) {
+		t.Fatalf("Countries() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Countries() = %v, want %v", got, want)
+		}
+	}
+
+	if len(ForCountry("mx")) != 2 {
+		t.Errorf("expected 2 documents for MX, got %d", len(ForCountry("mx")))
+	}
+}
+
+func TestRegister(t *testing.T) {
+	if err := Register(New("br", "cpf", nil, nil, nil, nil)); err == nil {
+		t.Error("expected error for duplicate registration")
+	}
+}
+
+func TestDetect(t *testing.T) {
+	id, ok := Detect("ES", "X1234567L")
+	if !ok || id.Kind() != "NIE" {
+		t.Errorf("Detect did not identify the NIE")
+	}
+
+	if _, ok := Detect("ES", "X1234567A"); ok {
+		t.Error("Detect accepted an invalid value")
+	}
+}
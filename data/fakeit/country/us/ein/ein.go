@@ -0,0 +1,50 @@
+// Package ein generates and validates US Employer Identification Numbers.
+// EINs carry no check digit; validation checks the two-digit IRS campus prefix.
+package ein
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/inovacc/toolkit/data/algorithm/random"
+)
+
+// prefixes lists the campus prefixes assigned by the IRS.
+var prefixes = []string{
+	"01", "02", "03", "04", "05", "06", "10", "11", "12", "13", "14", "15", "16",
+	"20", "21", "22", "23", "24", "25", "26", "27", "30", "31", "32", "33", "34",
+	"35", "36", "37", "38", "39", "40", "41", "42", "43", "44", "45", "46", "47",
+	"48", "50", "51", "52", "53", "54", "55", "56", "57", "58", "59", "60", "61",
+	"62", "63", "64", "65", "66", "67", "68", "71", "72", "73", "74", "75", "76",
+	"77", "80", "81", "82", "83", "84", "85", "86", "87", "88", "90", "91", "92",
+	"93", "94", "95", "98", "99",
+}
+
+// GenerateEIN generates a random, valid EIN in unformatted form (9 digits).
+// By default it uses crypto/rand; pass random.WithSeed or random.WithSource for reproducible output.
+func GenerateEIN(opts ...random.Option) string {
+	rng := random.NewRand(opts...)
+	return fmt.Sprintf("%s%07d", prefixes[rng.IntN(len(prefixes))], rng.IntN(10000000))
+}
+
+// ValidateEIN verifies if a given EIN has 9 digits and a valid campus prefix.
+func ValidateEIN(value string) bool {
+	ein := UnformatEIN(value)
+	return len(ein) == 9 && slices.Contains(prefixes, ein[:2])
+}
+
+// FormatEIN takes an EIN string (with or without formatting) and returns it in the formatted style: XX-XXXXXXX
+func FormatEIN(ein string) string {
+	ein = UnformatEIN(ein)
+	if len(ein) != 9 {
+		return "Invalid EIN"
+	}
+	return ein[:2] + "-" + ein[2:]
+}
+
+// UnformatEIN removes all non-numeric characters from the EIN string.
+func UnformatEIN(ein string) string {
+	re := regexp.MustCompile(`[^0-9]`)
+	return re.ReplaceAllString(ein, "")
+}
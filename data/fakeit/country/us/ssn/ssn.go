@@ -0,0 +1,49 @@
+// Package ssn generates and validates US Social Security Numbers.
+// SSNs carry no check digit; validation applies the SSA rules for unassigned areas, groups and serials.
+package ssn
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/inovacc/toolkit/data/algorithm/random"
+)
+
+// GenerateSSN generates a random, valid SSN in unformatted form (9 digits).
+// By default it uses crypto/rand; pass random.WithSeed or random.WithSource for reproducible output.
+func GenerateSSN(opts ...random.Option) string {
+	rng := random.NewRand(opts...)
+
+	area := 1 + rng.IntN(899)
+	if area == 666 {
+		area = 665
+	}
+	return fmt.Sprintf("%03d%02d%04d", area, 1+rng.IntN(99), 1+rng.IntN(9999))
+}
+
+// ValidateSSN verifies if a given SSN is syntactically valid: the area is not 000, 666
+// or 900-999, the group is not 00 and the serial is not 0000.
+func ValidateSSN(value string) bool {
+	ssn := UnformatSSN(value)
+	if len(ssn) != 9 {
+		return false
+	}
+
+	area, group, serial := ssn[:3], ssn[3:5], ssn[5:]
+	return area != "000" && area != "666" && area[0] != '9' && group != "00" && serial != "0000"
+}
+
+// FormatSSN takes an SSN string (with or without formatting) and returns it in the formatted style: XXX-XX-XXXX
+func FormatSSN(ssn string) string {
+	ssn = UnformatSSN(ssn)
+	if len(ssn) != 9 {
+		return "Invalid SSN"
+	}
+	return ssn[:3] + "-" + ssn[3:5] + "-" + ssn[5:]
+}
+
+// UnformatSSN removes all non-numeric characters from the SSN string.
+func UnformatSSN(ssn string) string {
+	re := regexp.MustCompile(`[^0-9]`)
+	return re.ReplaceAllString(ssn, "")
+}
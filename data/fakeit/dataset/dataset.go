@@ -0,0 +1,191 @@
+// Package dataset streams synthetic tables to CSV, JSON Lines or SQL INSERT
+// statements. Each column is filled by a fakeit generator spec, so every
+// generator usable in a `fake` struct tag is usable here, including custom
+// ones added with fakeit.Register.
+//
+// Rows are generated and written one at a time, so memory use does not grow
+// with the number of rows. A seeded run (WithRandom(random.WithSeed(n)))
+// reproduces the same output, except for clock-based generators such as uuid7.
+package dataset
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/inovacc/toolkit/data/algorithm/random"
+	"github.com/inovacc/toolkit/data/fakeit"
+)
+
+// Column is one column of the generated table.
+type Column struct {
+	Name string
+	// Spec is a fakeit generator spec, e.g. "cpf,formatted" or "int,1,100".
+	Spec string
+}
+
+// Col is shorthand for Column{Name: name, Spec: spec}.
+func Col(name, spec string) Column {
+	return Column{Name: name, Spec: spec}
+}
+
+// Schema is the ordered list of columns of a table.
+type Schema []Column
+
+// Format selects the output encoding.
+type Format int
+
+const (
+	CSV Format = iota
+	JSONLines
+	SQL
+)
+
+// Dialect selects the SQL flavour used for identifiers and literals.
+type Dialect int
+
+const (
+	Postgres Dialect = iota
+	MySQL
+	SQLite
+)
+
+type OptsFn func(opts *Config)
+
+// Config holds the output settings.
+type Config struct {
+	format    Format
+	dialect   Dialect
+	table     string
+	batchSize int
+	header    bool
+	random    []random.Option
+}
+
+// NewConfig returns a Config for CSV with a header row, and SQL defaults of
+// table "data", Postgres dialect and 500 rows per INSERT.
+func NewConfig(o ...OptsFn) *Config {
+	cfg := &Config{
+		format:    CSV,
+		dialect:   Postgres,
+		table:     "data",
+		batchSize: 500,
+		header:    true,
+	}
+	for _, fn := range o {
+		fn(cfg)
+	}
+	return cfg
+}
+
+// WithFormat selects CSV, JSONLines or SQL output.
+func WithFormat(f Format) OptsFn {
+	return func(opts *Config) {
+		opts.format = f
+	}
+}
+
+// WithDialect selects the SQL dialect.
+func WithDialect(d Dialect) OptsFn {
+	return func(opts *Config) {
+		opts.dialect = d
+	}
+}
+
+// WithTable sets the table name used in SQL INSERT statements.
+func WithTable(name string) OptsFn {
+	return func(opts *Config) {
+		opts.table = name
+	}
+}
+
+// WithBatchSize sets how many rows each SQL INSERT statement holds.
+func WithBatchSize(n int) OptsFn {
+	return func(opts *Config) {
+		if n > 0 {
+			opts.batchSize = n
+		}
+	}
+}
+
+// WithHeader enables or disables the CSV header row.
+func WithHeader(header bool) OptsFn {
+	return func(opts *Config) {
+		opts.header = header
+	}
+}
+
+// WithRandom sets the random source options, e.g. random.WithSeed for reproducible output.
+func WithRandom(r ...random.Option) OptsFn {
+	return func(opts *Config) {
+		opts.random = r
+	}
+}
+
+// encoder writes rows in one output format.
+type encoder interface {
+	begin() error
+	row(values []any) error
+	end() error
+}
+
+// Generate writes rows rows of schema to w. Every spec is resolved before
+// anything is written, so an unknown generator fails even when rows is 0.
+func Generate(w io.Writer, schema Schema, rows int, o ...OptsFn) error {
+	if len(schema) == 0 {
+		return errors.New("dataset: empty schema")
+	}
+	if rows < 0 {
+		return fmt.Errorf("dataset: invalid row count %d", rows)
+	}
+
+	gens := make([]fakeit.Generator, len(schema))
+	args := make([][]string, len(schema))
+	for i, col := range schema {
+		g, a, err := fakeit.Lookup(col.Spec)
+		if err != nil {
+			return fmt.Errorf("dataset: column %s: %w", col.Name, err)
+		}
+		gens[i], args[i] = g, a
+	}
+
+	cfg := NewConfig(o...)
+	bw := bufio.NewWriter(w)
+
+	var enc encoder
+	switch cfg.format {
+	case CSV:
+		enc = newCSVEncoder(bw, schema, cfg)
+	case JSONLines:
+		enc = newJSONEncoder(bw, schema)
+	case SQL:
+		enc = newSQLEncoder(bw, schema, cfg)
+	default:
+		return fmt.Errorf("dataset: unknown format %d", cfg.format)
+	}
+
+	src := random.NewOptions(cfg.random...).Source
+	if err := enc.begin(); err != nil {
+		return err
+	}
+
+	values := make([]any, len(schema))
+	for i := 0; i < rows; i++ {
+		for j, col := range schema {
+			v, err := gens[j](src, args[j])
+			if err != nil {
+				return fmt.Errorf("dataset: column %s: %w", col.Name, err)
+			}
+			values[j] = v
+		}
+		if err := enc.row(values); err != nil {
+			return err
+		}
+	}
+
+	if err := enc.end(); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
@@ -0,0 +1,160 @@
+package dataset
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/inovacc/toolkit/data/algorithm/random"
+	"github.com/inovacc/toolkit/data/fakeit/country/br/cpf"
+)
+
+var schema = Schema{
+	Col("id", "uuid"),
+	Col("name", "name"),
+	Col("cpf", "cpf,formatted"),
+	Col("age", "int,18,90"),
+	Col("active", "bool"),
+	Col("card", "creditcard"),
+	Col("note", "oneof,it's,plain"),
+}
+
+func TestCSV(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Generate(&buf, schema, 20); err != nil {
+		t.Fatal(err)
+	}
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 21 || records[0][2] != "cpf" {
+		t.Fatalf("unexpected CSV: %v", records[:1])
+	}
+	for _, r := range records[1:] {
+		if !cpf.ValidateCPF(r[2]) {
+			t.Errorf("invalid CPF %q", r[2])
+		}
+	}
+}
+
+func TestJSONLines(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Generate(&buf, schema, 10, WithFormat(JSONLines)); err != nil {
+		t.Fatal(err)
+	}
+
+	sc := bufio.NewScanner(&buf)
+	n := 0
+	for sc.Scan() {
+		var row map[string]any
+		if err := json.Unmarshal(sc.Bytes(), &row); err != nil {
+			t.Fatalf("line %d: %v", n, err)
+		}
+		if _, ok := row["age"].(float64); !ok {
+			t.Errorf("age is not a number: %v", row["age"])
+		}
+		if !strings.HasPrefix(sc.Text(), `{"id":`) {
+			t.Errorf("columns out of order: %s", sc.Text())
+		}
+		n++
+	}
+	if n != 10 {
+		t.Errorf("expected 10 lines, got %d", n)
+	}
+}
+
+func TestSQL(t *testing.T) {
+	cases := map[Dialect]string{
+		Postgres: `INSERT INTO "people" ("id", "name", "cpf", "age", "active", "card", "note") VALUES`,
+		MySQL:    "INSERT INTO `people` (`id`, `name`, `cpf`, `age`, `active`, `card`, `note`) VALUES",
+		SQLite:   `INSERT INTO "people" ("id", "name", "cpf", "age", "active", "card", "note") VALUES`,
+	}
+
+	for dialect, header := range cases {
+		var buf bytes.Buffer
+		err := Generate(&buf, schema, 25, WithFormat(SQL), WithDialect(dialect), WithTable("people"), WithBatchSize(10),
+			WithRandom(random.WithSeed(1)))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		out := buf.String()
+		if got := strings.Count(out, header); got != 3 {
+			t.Errorf("dialect %d: expected 3 INSERT batches, got %d", dialect, got)
+		}
+		if got := strings.Count(out, ";\n"); got != 3 {
+			t.Errorf("dialect %d: expected 3 statements, got %d", dialect, got)
+		}
+		if strings.Contains(out, "it's") {
+			t.Errorf("dialect %d: unescaped quote", dialect)
+		}
+	}
+}
+
+func TestSeeded(t *testing.T) {
+	var a, b bytes.Buffer
+	if err := Generate(&a, schema, 50, WithRandom(random.WithSeed(7))); err != nil {
+		t.Fatal(err)
+	}
+	if err := Generate(&b, schema, 50, WithRandom(random.WithSeed(7))); err != nil {
+		t.Fatal(err)
+	}
+	if a.String() != b.String() {
+		t.Error("seeded runs differ")
+	}
+}
+
+type countingWriter struct{ n int }
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.n += len(p)
+	return len(p), nil
+}
+
+func TestStreaming(t *testing.T) {
+	w := &countingWriter{}
+	if err := Generate(w, Schema{Col("n", "int"), Col("doc", "cpf")}, 100000, WithFormat(JSONLines)); err != nil {
+		t.Fatal(err)
+	}
+	if w.n == 0 {
+		t.Error("nothing written")
+	}
+}
+
+func TestErrors(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Generate(&buf, nil, 1); err == nil {
+		t.Error("expected error for empty schema")
+	}
+	if err := Generate(&buf, Schema{Col("x", "nope")}, 1); err == nil {
+		t.Error("expected error for unknown generator")
+	}
+	if err := Generate(&buf, Schema{Col("x", "nope")}, 0); err == nil {
+		t.Error("expected error for unknown generator without rows")
+	}
+}
+
+func TestSQLFloats(t *testing.T) {
+	cases := map[Dialect][]string{
+		Postgres: {"1.5", "'NaN'", "'Infinity'", "'-Infinity'"},
+		MySQL:    {"1.5", "NULL", "NULL", "NULL"},
+		SQLite:   {"1.5", "NULL", "NULL", "NULL"},
+	}
+	for dialect, want := range cases {
+		e := &sqlEncoder{dialect: dialect}
+		for i, f := range []float64{1.5, math.NaN(), math.Inf(1), math.Inf(-1)} {
+			if got := e.literal(f); got != want[i] {
+				t.Errorf("dialect %d: literal(%v) = %s, want %s", dialect, f, got, want[i])
+			}
+		}
+		if got := e.literal(float32(math.Inf(1))); got != want[2] {
+			t.Errorf("dialect %d: literal(float32 +Inf) = %s, want %s", dialect, got, want[2])
+		}
+	}
+}
@@ -0,0 +1,226 @@
+package dataset
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// text renders a value for CSV output.
+func text(v any) string {
+	switch x := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return x
+	case time.Time:
+		return x.Format(time.RFC3339)
+	case []byte:
+		return hex.EncodeToString(x)
+	case fmt.Stringer:
+		return x.String()
+	}
+	return fmt.Sprint(v)
+}
+
+type csvEncoder struct {
+	w      *csv.Writer
+	schema Schema
+	header bool
+	record []string
+}
+
+func newCSVEncoder(w *bufio.Writer, schema Schema, cfg *Config) *csvEncoder {
+	return &csvEncoder{w: csv.NewWriter(w), schema: schema, header: cfg.header, record: make([]string, len(schema))}
+}
+
+func (e *csvEncoder) begin() error {
+	if !e.header {
+		return nil
+	}
+	for i, col := range e.schema {
+		e.record[i] = col.Name
+	}
+	return e.w.Write(e.record)
+}
+
+func (e *csvEncoder) row(values []any) error {
+	for i, v := range values {
+		e.record[i] = text(v)
+	}
+	return e.w.Write(e.record)
+}
+
+func (e *csvEncoder) end() error {
+	e.w.Flush()
+	return e.w.Error()
+}
+
+type jsonEncoder struct {
+	w    *bufio.Writer
+	keys [][]byte
+}
+
+func newJSONEncoder(w *bufio.Writer, schema Schema) *jsonEncoder {
+	keys := make([][]byte, len(schema))
+	for i, col := range schema {
+		keys[i], _ = json.Marshal(col.Name)
+	}
+	return &jsonEncoder{w: w, keys: keys}
+}
+
+func (e *jsonEncoder) begin() error { return nil }
+
+// row writes one object per line, keeping the schema's column order.
+func (e *jsonEncoder) row(values []any) error {
+	e.w.WriteByte('{')
+	for i, v := range values {
+		if i > 0 {
+			e.w.WriteByte(',')
+		}
+		e.w.Write(e.keys[i])
+		e.w.WriteByte(':')
+
+		if s, ok := v.(fmt.Stringer); ok {
+			if _, isTime := v.(time.Time); !isTime {
+				v = s.String()
+			}
+		}
+		b, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Errorf("dataset: %w", err)
+		}
+		e.w.Write(b)
+	}
+	e.w.WriteByte('}')
+	return e.w.WriteByte('\n')
+}
+
+func (e *jsonEncoder) end() error { return nil }
+
+type sqlEncoder struct {
+	w       *bufio.Writer
+	dialect Dialect
+	insert  string
+	batch   int
+	pending int
+}
+
+func newSQLEncoder(w *bufio.Writer, schema Schema, cfg *Config) *sqlEncoder {
+	e := &sqlEncoder{w: w, dialect: cfg.dialect, batch: cfg.batchSize}
+
+	cols := make([]string, len(schema))
+	for i, col := range schema {
+		cols[i] = e.identifier(col.Name)
+	}
+	e.insert = fmt.Sprintf("INSERT INTO %s (%s) VALUES\n", e.identifier(cfg.table), strings.Join(cols, ", "))
+	return e
+}
+
+func (e *sqlEncoder) begin() error { return nil }
+
+func (e *sqlEncoder) row(values []any) error {
+	if e.pending == 0 {
+		e.w.WriteString(e.insert)
+	} else {
+		e.w.WriteString(",\n")
+	}
+
+	e.w.WriteByte('(')
+	for i, v := range values {
+		if i > 0 {
+			e.w.WriteString(", ")
+		}
+		e.w.WriteString(e.literal(v))
+	}
+	e.w.WriteByte(')')
+
+	if e.pending++; e.pending == e.batch {
+		return e.flush()
+	}
+	return nil
+}
+
+func (e *sqlEncoder) end() error {
+	if e.pending > 0 {
+		return e.flush()
+	}
+	return nil
+}
+
+func (e *sqlEncoder) flush() error {
+	e.pending = 0
+	_, err := e.w.WriteString(";\n")
+	return err
+}
+
+// identifier quotes a table or column name for the dialect.
+func (e *sqlEncoder) identifier(name string) string {
+	if e.dialect == MySQL {
+		return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+	}
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+// literal renders a value as an SQL literal for the dialect.
+func (e *sqlEncoder) literal(v any) string {
+	switch x := v.(type) {
+	case nil:
+		return "NULL"
+	case bool:
+		if e.dialect == Postgres {
+			return strings.ToUpper(strconv.FormatBool(x))
+		}
+		if x {
+			return "1"
+		}
+		return "0"
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprint(x)
+	case float32:
+		return e.float(float64(x), 32)
+	case float64:
+		return e.float(x, 64)
+	case time.Time:
+		return e.quote(x.UTC().Format("2006-01-02 15:04:05"))
+	case []byte:
+		if e.dialect == Postgres {
+			return `'\x` + hex.EncodeToString(x) + `'`
+		}
+		return "X'" + hex.EncodeToString(x) + "'"
+	}
+	return e.quote(text(v))
+}
+
+// float renders a float literal. NaN and infinities have no numeric literal:
+// Postgres accepts them as quoted strings, the other dialects get NULL.
+func (e *sqlEncoder) float(f float64, bitSize int) string {
+	if !math.IsNaN(f) && !math.IsInf(f, 0) {
+		return strconv.FormatFloat(f, 'g', -1, bitSize)
+	}
+	if e.dialect != Postgres {
+		return "NULL"
+	}
+	switch {
+	case math.IsNaN(f):
+		return "'NaN'"
+	case f > 0:
+		return "'Infinity'"
+	default:
+		return "'-Infinity'"
+	}
+}
+
+// quote escapes a string literal. MySQL also treats backslash as an escape character.
+func (e *sqlEncoder) quote(s string) string {
+	if e.dialect == MySQL {
+		s = strings.ReplaceAll(s, `\`, `\\`)
+	}
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
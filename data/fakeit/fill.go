@@ -0,0 +1,338 @@
+// Package fakeit populates structs with fake data driven by struct tags.
+//
+// A field tagged `fake:"name,arg1,arg2"` is set by the generator registered
+// under name, e.g. `fake:"cpf"`, `fake:"cnpj,formatted"` or `fake:"int,10,99"`.
+// Untagged structs, pointers to structs, slices and maps of structs are walked
+// recursively; untagged scalar fields are left alone, and `fake:"-"` skips a field.
+// Untagged pointers and containers of a struct type that is already being filled,
+// such as the Parent and Children of a tree node, are left nil.
+//
+// Slices and maps get a random length between 1 and 3 unless the field has a
+// `fakelen:"n"` or `fakelen:"min,max"` tag; the field's generator is applied to
+// each element (map values for maps, with keys generated from their type).
+package fakeit
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/inovacc/toolkit/data/algorithm/random"
+)
+
+// Generator produces the value for a tagged field. args holds the comma-separated
+// tag arguments after the generator name. The returned value is converted to the
+// field's type when possible.
+type Generator func(src random.Source, args []string) (any, error)
+
+var (
+	mu         sync.RWMutex
+	generators = make(map[string]Generator)
+)
+
+// Register makes a generator available to `fake` tags under name. Registering an
+// existing name replaces it, so built-in generators can be overridden.
+func Register(name string, g Generator) {
+	mu.Lock()
+	defer mu.Unlock()
+	generators[strings.ToLower(name)] = g
+}
+
+// Generators returns the names of the registered generators.
+func Generators() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	names := make([]string, 0, len(generators))
+	for name := range generators {
+		names = append(names, name)
+	}
+	return names
+}
+
+// Generate returns one value from the generator described by spec, using the
+// same syntax as a `fake` tag, e.g. "cpf,formatted" or "int,1,10".
+func Generate(spec string, src random.Source) (any, error) {
+	g, args, err := Lookup(spec)
+	if err != nil {
+		return nil, err
+	}
+	return g(src, args)
+}
+
+// Lookup splits a spec into the generator name and its arguments and resolves
+// the generator, so a spec used for many values is only parsed once.
+func Lookup(spec string) (Generator, []string, error) {
+	name, args, _ := strings.Cut(spec, ",")
+	name = strings.ToLower(strings.TrimSpace(name))
+
+	var list []string
+	if args != "" {
+		list = strings.Split(args, ",")
+	}
+
+	mu.RLock()
+	g, ok := generators[name]
+	mu.RUnlock()
+	if !ok {
+		return nil, nil, fmt.Errorf("unknown generator %q", name)
+	}
+	return g, list, nil
+}
+
+// Fill populates the struct pointed to by v from its `fake` tags.
+// By default it uses crypto/rand; pass random.WithSeed or random.WithSource for reproducible output.
+func Fill(v any, opts ...random.Option) error {
+	val := reflect.ValueOf(v)
+	if val.Kind() != reflect.Ptr || val.IsNil() {
+		return errors.New("fakeit: Fill requires a non-nil pointer")
+	}
+	if val.Elem().Kind() != reflect.Struct {
+		return errors.New("fakeit: Fill requires a pointer to a struct")
+	}
+
+	f := &filler{src: random.NewOptions(opts...).Source, filling: make(map[reflect.Type]bool)}
+	f.rng = rand.New(f.src)
+	return f.fillStruct(val.Elem())
+}
+
+type filler struct {
+	src random.Source
+	rng *rand.Rand
+	// filling holds the struct types on the current path, so self-referencing
+	// types such as trees are filled one level deep instead of recursively
+	filling map[reflect.Type]bool
+}
+
+func (f *filler) fillStruct(val reflect.Value) error {
+	typ := val.Type()
+	f.filling[typ] = true
+	defer delete(f.filling, typ)
+
+	for i := 0; i < val.NumField(); i++ {
+		field := val.Field(i)
+		sf := typ.Field(i)
+		if !field.CanSet() {
+			continue
+		}
+
+		tag, tagged := sf.Tag.Lookup("fake")
+		if tag == "-" {
+			continue
+		}
+
+		var err error
+		if tagged {
+			err = f.fillTagged(field, tag, sf.Tag.Get("fakelen"))
+		} else {
+			err = f.fillUntagged(field, sf.Tag.Get("fakelen"))
+		}
+		if err != nil {
+			return fmt.Errorf("fakeit: field %s: %w", sf.Name, err)
+		}
+	}
+	return nil
+}
+
+// fillUntagged recurses into containers of structs and leaves everything else
+// untouched, including pointers and containers of a struct type being filled.
+func (f *filler) fillUntagged(field reflect.Value, lenTag string) error {
+	switch field.Kind() {
+	case reflect.Struct:
+		return f.fillStruct(field)
+	case reflect.Ptr:
+		elem := field.Type().Elem()
+		if elem.Kind() != reflect.Struct || f.filling[elem] {
+			return nil
+		}
+		if field.IsNil() {
+			field.Set(reflect.New(elem))
+		}
+		return f.fillStruct(field.Elem())
+	case reflect.Slice, reflect.Array, reflect.Map:
+		elem := field.Type().Elem()
+		if !holdsStruct(elem) || f.filling[structType(elem)] {
+			return nil
+		}
+		return f.fillContainer(field, lenTag, func(elem reflect.Value) error {
+			return f.fillUntagged(elem, "")
+		})
+	}
+	return nil
+}
+
+// fillTagged sets a field, or every element of a container field, from its generator.
+func (f *filler) fillTagged(field reflect.Value, tag, lenTag string) error {
+	g, list, err := Lookup(tag)
+	if err != nil {
+		return err
+	}
+
+	set := func(target reflect.Value) error {
+		v, err := g(f.src, list)
+		if err != nil {
+			return err
+		}
+		return assign(target, v)
+	}
+
+	switch field.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		// Byte slices are values in their own right, e.g. `fake:"bytes,16"`
+		if field.Type() == reflect.TypeOf([]byte(nil)) {
+			return set(field)
+		}
+		return f.fillContainer(field, lenTag, set)
+	case reflect.Ptr:
+		if field.IsNil() {
+			field.Set(reflect.New(field.Type().Elem()))
+		}
+		return set(field.Elem())
+	}
+	return set(field)
+}
+
+// fillContainer sizes a slice or map from lenTag and calls fill on each element.
+// Arrays keep their fixed length.
+func (f *filler) fillContainer(field reflect.Value, lenTag string, fill func(reflect.Value) error) error {
+	n, err := f.length(lenTag)
+	if err != nil {
+		return err
+	}
+
+	switch field.Kind() {
+	case reflect.Array:
+		n = field.Len()
+	case reflect.Slice:
+		field.Set(reflect.MakeSlice(field.Type(), n, n))
+	case reflect.Map:
+		keyType := field.Type().Key()
+		if size, ok := keySpace(keyType); ok && uint64(n) > size {
+			return fmt.Errorf("cannot generate %d distinct %s map keys", n, keyType)
+		}
+
+		m := reflect.MakeMapWithSize(field.Type(), n)
+		for m.Len() < n {
+			key := reflect.New(keyType).Elem()
+			if err := f.randomScalar(key); err != nil {
+				return err
+			}
+			if m.MapIndex(key).IsValid() {
+				continue // collision, draw another key
+			}
+			elem := reflect.New(field.Type().Elem()).Elem()
+			if err := fill(elem); err != nil {
+				return err
+			}
+			m.SetMapIndex(key, elem)
+		}
+		field.Set(m)
+		return nil
+	}
+
+	for i := 0; i < n; i++ {
+		if err := fill(field.Index(i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// length parses a `fakelen` tag: "n" or "min,max". An empty tag means 1 to 3.
+func (f *filler) length(tag string) (int, error) {
+	if tag == "" {
+		return 1 + f.rng.IntN(3), nil
+	}
+
+	lo, hi, found := strings.Cut(tag, ",")
+	minLen, err := strconv.Atoi(strings.TrimSpace(lo))
+	if err != nil || minLen < 0 {
+		return 0, fmt.Errorf("invalid fakelen %q", tag)
+	}
+	if !found {
+		return minLen, nil
+	}
+
+	maxLen, err := strconv.Atoi(strings.TrimSpace(hi))
+	if err != nil || maxLen < minLen {
+		return 0, fmt.Errorf("invalid fakelen %q", tag)
+	}
+	return minLen + f.rng.IntN(maxLen-minLen+1), nil
+}
+
+// randomScalar fills map keys, which have no tag of their own. Integers are
+// drawn over the whole range of their type.
+func (f *filler) randomScalar(v reflect.Value) error {
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(random.New(f.src).String(8))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		// Keep the low bits and sign-extend them
+		shift := 64 - v.Type().Bits()
+		v.SetInt(int64(f.src.Uint64()<<shift) >> shift)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		v.SetUint(f.src.Uint64() >> (64 - v.Type().Bits()))
+	default:
+		return fmt.Errorf("unsupported map key type %s", v.Type())
+	}
+	return nil
+}
+
+// keySpace returns how many distinct values an integer key type holds, and
+// false for types whose range is too large to matter.
+func keySpace(t reflect.Type) (uint64, bool) {
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if t.Bits() < 64 {
+			return 1 << t.Bits(), true
+		}
+	}
+	return 0, false
+}
+
+// assign stores value into target, converting between numeric types and
+// formatting non-string values when the target is a string.
+func assign(target reflect.Value, value any) error {
+	v := reflect.ValueOf(value)
+	if !v.IsValid() {
+		return nil
+	}
+
+	tt := target.Type()
+	switch {
+	case v.Type().AssignableTo(tt):
+		target.Set(v)
+	case tt.Kind() == reflect.String:
+		target.SetString(fmt.Sprint(value))
+	case isNumeric(v.Kind()) && isNumeric(tt.Kind()):
+		target.Set(v.Convert(tt))
+	case v.Kind() == reflect.String && tt.Kind() != reflect.String:
+		return fmt.Errorf("cannot assign string %q to %s", value, tt)
+	case v.Type().ConvertibleTo(tt):
+		target.Set(v.Convert(tt))
+	default:
+		return fmt.Errorf("cannot assign %T to %s", value, tt)
+	}
+	return nil
+}
+
+func isNumeric(k reflect.Kind) bool {
+	return k >= reflect.Int && k <= reflect.Float64
+}
+
+func holdsStruct(t reflect.Type) bool {
+	return structType(t).Kind() == reflect.Struct
+}
+
+// structType returns t, or the type t points to.
+func structType(t reflect.Type) reflect.Type {
+	if t.Kind() == reflect.Ptr {
+		return t.Elem()
+	}
+	return t
+}
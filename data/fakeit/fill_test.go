@@ -0,0 +1,215 @@
+package fakeit
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/inovacc/toolkit/data/algorithm/random"
+	"github.com/inovacc/toolkit/data/fakeit/country/br/cep"
+	"github.com/inovacc/toolkit/data/fakeit/country/br/cnpj"
+	"github.com/inovacc/toolkit/data/fakeit/country/br/cpf"
+	"github.com/inovacc/toolkit/data/uid"
+)
+
+type Contact struct {
+	Email string `fake:"email"`
+	Phone string `fake:"phone"`
+}
+
+type Customer struct {
+	Name      string            `fake:"name"`
+	CPF       string            `fake:"cpf"`
+	CNPJ      string            `fake:"cnpj,numeric,formatted"`
+	Age       int               `fake:"int,18,65"`
+	Score     float64           `fake:"float,0,10"`
+	Active    bool              `fake:"bool"`
+	ID        string            `fake:"uuid7"`
+	Status    string            `fake:"oneof,active,blocked"`
+	SKU       string            `fake:"regex,^[A-Z]{2,4}-\\d{3}$"`
+	Tags      []string          `fake:"string,5" fakelen:"4"`
+	Lucky     []int8            `fake:"int,1,9" fakelen:"2,3"`
+	Address   cep.Address       `fake:"address,SP"`
+	Contact   Contact           // nested struct, filled through its own tags
+	Backup    *Contact          // allocated when nil
+	Contacts  []Contact         `fakelen:"2"`
+	Meta      map[string]string `fake:"username" fakelen:"3"`
+	CreatedAt time.Time         `fake:"date"`
+	Ignored   string            `fake:"-"`
+	Untouched string
+	internal  string `fake:"cpf"`
+}
+
+func TestFill(t *testing.T) {
+	var c Customer
+	c.Ignored = "keep"
+	if err := Fill(&c); err != nil {
+		t.Fatal(err)
+	}
+
+	switch {
+	case c.Name == "":
+		t.Error("Name not filled")
+	case !cpf.ValidateCPF(c.CPF):
+		t.Errorf("invalid CPF %q", c.CPF)
+	case !cnpj.ValidateCNPJ(c.CNPJ) || cnpj.IsAlphanumeric(c.CNPJ) || !strings.Contains(c.CNPJ, "/"):
+		t.Errorf("invalid CNPJ %q", c.CNPJ)
+	case c.Age < 18 || c.Age > 65:
+		t.Errorf("Age out of range: %d", c.Age)
+	case c.Score < 0 || c.Score > 10:
+		t.Errorf("Score out of range: %f", c.Score)
+	case c.Status != "active" && c.Status != "blocked":
+		t.Errorf("unexpected Status %q", c.Status)
+	case len(c.Tags) != 4 || len(c.Tags[0]) != 5:
+		t.Errorf("unexpected Tags %v", c.Tags)
+	case len(c.Lucky) < 2 || len(c.Lucky) > 3:
+		t.Errorf("unexpected Lucky %v", c.Lucky)
+	case c.Address.UF != "SP":
+		t.Errorf("unexpected Address %v", c.Address)
+	case c.Contact.Email == "" || c.Backup == nil || c.Backup.Email == "":
+		t.Error("nested structs not filled")
+	case len(c.Contacts) != 2 || c.Contacts[1].Phone == "":
+		t.Errorf("unexpected Contacts %v", c.Contacts)
+	case len(c.Meta) != 3:
+		t.Errorf("unexpected Meta %v", c.Meta)
+	case c.CreatedAt.IsZero():
+		t.Error("CreatedAt not filled")
+	case c.Ignored != "keep" || c.Untouched != "" || c.internal != "":
+		t.Error("skipped fields were modified")
+	}
+
+	if u, err := uid.ParseUUID(c.ID); err != nil || u.Version() != 7 {
+		t.Errorf("invalid UUIDv7 %q", c.ID)
+	}
+}
+
+func TestFillSeeded(t *testing.T) {
+	type doc struct {
+		CPF  string `fake:"cpf,formatted"`
+		Age  int    `fake:"int"`
+		Name string `fake:"name"`
+	}
+
+	var a, b doc
+	if err := Fill(&a, random.WithSeed(3)); err != nil {
+		t.Fatal(err)
+	}
+	if err := Fill(&b, random.WithSeed(3)); err != nil {
+		t.Fatal(err)
+	}
+	if a != b {
+		t.Errorf("same seed produced %+v and %+v", a, b)
+	}
+}
+
+func TestRegister(t *testing.T) {
+	Register("answer", func(_ random.Source, _ []string) (any, error) { return 42, nil })
+	Register("broken", func(_ random.Source, _ []string) (any, error) { return nil, errors.New("boom") })
+
+	var v struct {
+		N uint16 `fake:"answer"`
+		S string `fake:"answer"`
+	}
+	if err := Fill(&v); err != nil {
+		t.Fatal(err)
+	}
+	if v.N != 42 || v.S != "42" {
+		t.Errorf("custom generator not applied: %+v", v)
+	}
+
+	var bad struct {
+		X string `fake:"broken"`
+	}
+	if err := Fill(&bad); err == nil {
+		t.Error("expected generator error")
+	}
+
+	var unknown struct {
+		X string `fake:"nope"`
+	}
+	if err := Fill(&unknown); err == nil {
+		t.Error("expected unknown generator error")
+	}
+}
+
+type node struct {
+	Name     string `fake:"name"`
+	Parent   *node
+	Children []*node
+	Siblings []node
+	Meta     struct {
+		Owner *node
+		Tags  []string `fake:"firstname" fakelen:"2"`
+	}
+}
+
+func TestFillSelfReferencing(t *testing.T) {
+	var n node
+	if err := Fill(&n, random.WithSeed(1)); err != nil {
+		t.Fatal(err)
+	}
+	if n.Name == "" || len(n.Meta.Tags) != 2 {
+		t.Errorf("fields not filled: %+v", n)
+	}
+	if n.Parent != nil || n.Children != nil || n.Siblings != nil || n.Meta.Owner != nil {
+		t.Errorf("self references were filled: %+v", n)
+	}
+}
+
+func TestFillMapLength(t *testing.T) {
+	var v struct {
+		Ints  map[int]int     `fake:"int" fakelen:"200"`
+		Small map[int8]int    `fake:"int" fakelen:"100"`
+		Bytes map[uint8]bool  `fake:"bool" fakelen:"256"`
+		Names map[string]bool `fake:"bool" fakelen:"50"`
+	}
+	if err := Fill(&v, random.WithSeed(1)); err != nil {
+		t.Fatal(err)
+	}
+	if len(v.Ints) != 200 || len(v.Small) != 100 || len(v.Bytes) != 256 || len(v.Names) != 50 {
+		t.Errorf("map lengths = %d, %d, %d, %d", len(v.Ints), len(v.Small), len(v.Bytes), len(v.Names))
+	}
+
+	var tooMany struct {
+		M map[uint8]int `fake:"int" fakelen:"257"`
+	}
+	if err := Fill(&tooMany); err == nil {
+		t.Error("expected error for more keys than a uint8 holds")
+	}
+}
+
+func TestFillErrors(t *testing.T) {
+	var s string
+	if err := Fill(&s); err == nil {
+		t.Error("expected error for non-struct")
+	}
+
+	if err := Fill(Customer{}); err == nil {
+		t.Error("expected error for non-pointer")
+	}
+
+	var mismatch struct {
+		N int `fake:"cpf"`
+	}
+	if err := Fill(&mismatch); err == nil {
+		t.Error("expected error assigning string to int")
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	v, err := Generate("int,5,5", random.NewSeededSource(1))
+	if err != nil || v != 5 {
+		t.Errorf("Generate = %v, %v", v, err)
+	}
+
+	if _, err := Generate("nope", random.NewSeededSource(1)); err == nil {
+		t.Error("expected unknown generator error")
+	}
+
+	for _, tag := range []string{"bytes,-1", "string,-1"} {
+		if _, err := Generate(tag, random.NewSeededSource(1)); err == nil {
+			t.Errorf("Generate(%q): expected error for a negative length", tag)
+		}
+	}
+}
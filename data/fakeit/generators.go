@@ -0,0 +1,262 @@
+package fakeit
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/inovacc/toolkit/data/algorithm/random"
+	"github.com/inovacc/toolkit/data/algorithm/random/randomstring"
+	"github.com/inovacc/toolkit/data/fakeit/business/card"
+	"github.com/inovacc/toolkit/data/fakeit/business/iban"
+	"github.com/inovacc/toolkit/data/fakeit/country/br/cep"
+	"github.com/inovacc/toolkit/data/fakeit/country/br/cnh"
+	"github.com/inovacc/toolkit/data/fakeit/country/br/cnpj"
+	"github.com/inovacc/toolkit/data/fakeit/country/br/cns"
+	"github.com/inovacc/toolkit/data/fakeit/country/br/cpf"
+	"github.com/inovacc/toolkit/data/fakeit/country/br/ie"
+	"github.com/inovacc/toolkit/data/fakeit/country/br/pis"
+	"github.com/inovacc/toolkit/data/fakeit/country/br/pix"
+	"github.com/inovacc/toolkit/data/fakeit/country/br/placa"
+	"github.com/inovacc/toolkit/data/fakeit/country/br/renavam"
+	"github.com/inovacc/toolkit/data/fakeit/country/br/rg"
+	"github.com/inovacc/toolkit/data/fakeit/country/br/titulo"
+	"github.com/inovacc/toolkit/data/fakeit/namesgenerator"
+	"github.com/inovacc/toolkit/data/uid"
+)
+
+func init() {
+	// Brazilian documents accept a "formatted" argument
+	document := func(generate func(...random.Option) string, format func(string) string) Generator {
+		return func(src random.Source, args []string) (any, error) {
+			v := generate(random.WithSource(src))
+			if hasArg(args, "formatted") {
+				v = format(v)
+			}
+			return v, nil
+		}
+	}
+	Register("cpf", document(cpf.GenerateCPF, cpf.FormatCPF))
+	Register("rg", document(rg.GenerateRG, rg.FormatRG))
+	Register("cnh", document(cnh.GenerateCNH, cnh.FormatCNH))
+	Register("pis", document(pis.GeneratePIS, pis.FormatPIS))
+	Register("renavam", document(renavam.GenerateRENAVAM, renavam.FormatRENAVAM))
+	Register("cns", document(cns.GenerateCNS, cns.FormatCNS))
+	Register("titulo", document(titulo.GenerateTitulo, titulo.FormatTitulo))
+	Register("placa", document(placa.GeneratePlaca, placa.FormatPlaca))
+
+	Register("cnpj", func(src random.Source, args []string) (any, error) {
+		mode := cnpj.Alphanumeric
+		if hasArg(args, "numeric") {
+			mode = cnpj.Numeric
+		}
+		v := cnpj.GenerateCNPJMode(mode, random.WithSource(src))
+		if hasArg(args, "formatted") {
+			v = cnpj.FormatCNPJ(v)
+		}
+		return v, nil
+	})
+	Register("cep", func(src random.Source, args []string) (any, error) {
+		uf := firstArg(args, "formatted")
+		if uf == "" {
+			uf = cep.UFs()[random.NewRand(random.WithSource(src)).IntN(len(cep.UFs()))]
+		}
+		v, err := cep.GenerateCEP(uf, random.WithSource(src))
+		if err == nil && hasArg(args, "formatted") {
+			v = cep.FormatCEP(v)
+		}
+		return v, err
+	})
+	Register("ie", func(src random.Source, args []string) (any, error) {
+		uf := firstArg(args, "formatted")
+		if uf == "" {
+			uf = "SP"
+		}
+		v, err := ie.GenerateIE(uf, random.WithSource(src))
+		if err == nil && hasArg(args, "formatted") {
+			v = ie.FormatIE(uf, v)
+		}
+		return v, err
+	})
+	Register("address", func(src random.Source, args []string) (any, error) {
+		if uf := firstArg(args); uf != "" {
+			return cep.GenerateAddressForUF(uf, random.WithSource(src))
+		}
+		return cep.GenerateAddress(random.WithSource(src)), nil
+	})
+	Register("pixkey", func(src random.Source, args []string) (any, error) {
+		kinds := map[string]pix.KeyType{
+			"": pix.KeyEVP, "evp": pix.KeyEVP, "cpf": pix.KeyCPF, "cnpj": pix.KeyCNPJ,
+			"email": pix.KeyEmail, "phone": pix.KeyPhone,
+		}
+		kind, ok := kinds[firstArg(args)]
+		if !ok {
+			return nil, fmt.Errorf("unknown PIX key type %q", firstArg(args))
+		}
+		return pix.GenerateKey(kind, random.WithSource(src))
+	})
+	Register("iban", func(src random.Source, args []string) (any, error) {
+		country := strings.ToUpper(firstArg(args, "formatted"))
+		if country == "" {
+			country = "BR"
+		}
+		v, err := iban.Generate(country, random.WithSource(src))
+		if err == nil && hasArg(args, "formatted") {
+			v = iban.Format(v)
+		}
+		return v, err
+	})
+
+	Register("card", func(src random.Source, _ []string) (any, error) {
+		return card.GenerateCreditCard(true, random.WithSource(src)), nil
+	})
+	Register("creditcard", func(src random.Source, _ []string) (any, error) {
+		return card.GenerateCreditCard(true, random.WithSource(src)).Number, nil
+	})
+	Register("debitcard", func(src random.Source, _ []string) (any, error) {
+		return card.GenerateDebitCard(true, random.WithSource(src)).Number, nil
+	})
+
+	// Person and internet data from gofakeit
+	faker := func(fn func(f *gofakeit.Faker) string) Generator {
+		return func(src random.Source, _ []string) (any, error) {
+			return fn(gofakeit.NewFaker(src, true)), nil
+		}
+	}
+	Register("name", faker(func(f *gofakeit.Faker) string { return f.Name() }))
+	Register("firstname", faker(func(f *gofakeit.Faker) string { return f.FirstName() }))
+	Register("lastname", faker(func(f *gofakeit.Faker) string { return f.LastName() }))
+	Register("email", faker(func(f *gofakeit.Faker) string { return f.Email() }))
+	Register("phone", faker(func(f *gofakeit.Faker) string { return f.Phone() }))
+	Register("company", faker(func(f *gofakeit.Faker) string { return f.Company() }))
+	Register("url", faker(func(f *gofakeit.Faker) string { return f.URL() }))
+	Register("username", func(src random.Source, _ []string) (any, error) {
+		gen, err := namesgenerator.New(namesgenerator.WithRandom(random.WithSource(src)))
+		if err != nil {
+			return nil, err
+		}
+		return gen.Name()
+	})
+
+	// Primitive values
+	Register("int", func(src random.Source, args []string) (any, error) {
+		lo, hi, err := intRange(args, 0, 100)
+		if err != nil {
+			return nil, err
+		}
+		return lo + random.NewRand(random.WithSource(src)).IntN(hi-lo+1), nil
+	})
+	Register("float", func(src random.Source, args []string) (any, error) {
+		lo, hi := 0.0, 1.0
+		if len(args) == 2 {
+			var err1, err2 error
+			lo, err1 = strconv.ParseFloat(strings.TrimSpace(args[0]), 64)
+			hi, err2 = strconv.ParseFloat(strings.TrimSpace(args[1]), 64)
+			if err1 != nil || err2 != nil {
+				return nil, fmt.Errorf("invalid float range %v", args)
+			}
+		}
+		return random.New(src).Float(lo, hi)
+	})
+	Register("bool", func(src random.Source, _ []string) (any, error) {
+		return src.Uint64()&1 == 1, nil
+	})
+	Register("string", func(src random.Source, args []string) (any, error) {
+		n, err := length(args, 10)
+		if err != nil {
+			return nil, err
+		}
+		return random.New(src).String(n), nil
+	})
+	Register("bytes", func(src random.Source, args []string) (any, error) {
+		n, err := length(args, 16)
+		if err != nil {
+			return nil, err
+		}
+		return sourceBytes(src, n), nil
+	})
+	Register("regex", func(src random.Source, args []string) (any, error) {
+		// Patterns may contain commas, e.g. {2,4}
+		return randomstring.New(src).FromRegex(strings.Join(args, ","), 5)
+	})
+	Register("oneof", func(src random.Source, args []string) (any, error) {
+		if len(args) == 0 {
+			return nil, fmt.Errorf("oneof needs at least one option")
+		}
+		return args[random.NewRand(random.WithSource(src)).IntN(len(args))], nil
+	})
+	Register("date", func(src random.Source, _ []string) (any, error) {
+		return gofakeit.NewFaker(src, true).Date(), nil
+	})
+	Register("uuid", func(src random.Source, _ []string) (any, error) {
+		u, err := uid.NewRandomFromReader(bytes.NewReader(sourceBytes(src, 16)))
+		return u.String(), err
+	})
+	Register("uuid7", func(src random.Source, _ []string) (any, error) {
+		u, err := uid.NewV7FromReader(bytes.NewReader(sourceBytes(src, 16)))
+		return u.String(), err
+	})
+}
+
+// hasArg reports whether args contains flag.
+func hasArg(args []string, flag string) bool {
+	for _, a := range args {
+		if strings.EqualFold(strings.TrimSpace(a), flag) {
+			return true
+		}
+	}
+	return false
+}
+
+// firstArg returns the first argument that is not one of the given flags.
+func firstArg(args []string, flags ...string) string {
+	for _, a := range args {
+		a = strings.TrimSpace(a)
+		if !hasArg(flags, a) {
+			return a
+		}
+	}
+	return ""
+}
+
+// intRange parses "min,max" or a single "n" (min = max = n), falling back to the defaults.
+func intRange(args []string, lo, hi int) (int, int, error) {
+	var err error
+	switch len(args) {
+	case 0:
+		return lo, hi, nil
+	case 1:
+		lo, err = strconv.Atoi(strings.TrimSpace(args[0]))
+		hi = lo
+	default:
+		if lo, err = strconv.Atoi(strings.TrimSpace(args[0])); err == nil {
+			hi, err = strconv.Atoi(strings.TrimSpace(args[1]))
+		}
+	}
+	if err != nil || hi < lo {
+		return 0, 0, fmt.Errorf("invalid range %v", args)
+	}
+	return lo, hi, nil
+}
+
+// length parses the length argument of a string or bytes tag, defaulting to def.
+func length(args []string, def int) (int, error) {
+	n, _, err := intRange(args, def, def)
+	if err != nil {
+		return 0, err
+	}
+	if n < 0 {
+		return 0, fmt.Errorf("invalid length %v: must not be negative", args)
+	}
+	return n, nil
+}
+
+func sourceBytes(src random.Source, n int) []byte {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = byte(src.Uint64())
+	}
+	return b
+}
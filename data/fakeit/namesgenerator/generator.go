@@ -0,0 +1,401 @@
+package namesgenerator
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/inovacc/toolkit/data/algorithm/random"
+	"github.com/inovacc/toolkit/data/mnemonic"
+)
+
+var (
+	// ErrExhausted is returned when no name is left to hand out: every combination
+	// is excluded, or every name of a Tracker namespace is taken.
+	ErrExhausted = errors.New("namesgenerator: namespace exhausted")
+
+	// ErrNoWords is returned by New when there are no word lists or one of them is empty.
+	ErrNoWords = errors.New("namesgenerator: empty word list")
+
+	// ErrSuffixDigits is returned by New when the suffix has more than MaxSuffixDigits digits.
+	ErrSuffixDigits = errors.New("namesgenerator: suffix too long")
+)
+
+// MaxSuffixDigits is the longest suffix WithSuffix accepts, so it fits in an int64.
+const MaxSuffixDigits = 18
+
+type OptsFn func(opts *Config)
+
+// Config holds the settings of a Generator.
+type Config struct {
+	lists     [][]string
+	separator string
+	digits    int
+	exclude   map[string]bool
+	random    []random.Option
+	// docker is true while the default lists are in use, to exclude boring_wozniak
+	docker bool
+}
+
+// NewConfig returns a Config for Docker-style names: an adjective and a surname
+// joined by "_", with no suffix and "boring_wozniak" excluded.
+func NewConfig(o ...OptsFn) *Config {
+	cfg := &Config{
+		lists:     [][]string{left[:], right[:]},
+		separator: "_",
+		exclude:   map[string]bool{},
+		docker:    true,
+	}
+	for _, fn := range o {
+		fn(cfg)
+	}
+	if cfg.docker {
+		cfg.exclude["boring\x00wozniak"] = true
+	}
+	return cfg
+}
+
+// WithWordLists replaces the word lists. A name takes one word from each list, in order.
+// The default exclusion of "boring_wozniak" is dropped.
+func WithWordLists(lists ...[]string) OptsFn {
+	return func(opts *Config) {
+		opts.lists = lists
+		opts.docker = false
+	}
+}
+
+// WithMnemonicWords builds names from count words of a BIP-39 word list. New fails
+// with ErrNoWords for an unknown language or a count below one. Combined with
+// WithSeparator("-"), WithMnemonicWords(mnemonic.Portuguese, 3) yields names like "abacate-gesto-remador".
+func WithMnemonicWords(lang mnemonic.LanguageStr, count int) OptsFn {
+	return func(opts *Config) {
+		words := mnemonic.WordList(lang)
+		opts.lists = make([][]string, max(0, count))
+		for i := range opts.lists {
+			opts.lists[i] = words
+		}
+		opts.docker = false
+	}
+}
+
+// WithSeparator sets the string placed between words.
+func WithSeparator(sep string) OptsFn {
+	return func(opts *Config) {
+		opts.separator = sep
+	}
+}
+
+// WithSuffix appends a zero-padded number of the given digits to every name, e.g. "eager_turing042".
+// At most MaxSuffixDigits digits are allowed.
+func WithSuffix(digits int) OptsFn {
+	return func(opts *Config) {
+		opts.digits = max(0, digits)
+	}
+}
+
+// WithExclude forbids specific word combinations, given as the words of the name.
+// Exclusions apply whatever the position of WithWordLists in the options.
+func WithExclude(words ...string) OptsFn {
+	return func(opts *Config) {
+		opts.exclude[strings.Join(words, "\x00")] = true
+	}
+}
+
+// WithRandom sets the random source options, e.g. random.WithSeed for reproducible names.
+func WithRandom(r ...random.Option) OptsFn {
+	return func(opts *Config) {
+		opts.random = r
+	}
+}
+
+// Generator produces names from a Config. It is safe for concurrent use.
+type Generator struct {
+	cfg *Config
+	mu  sync.Mutex
+	rng *rand.Rand
+}
+
+// New returns a Generator. It defaults to crypto/rand.
+func New(o ...OptsFn) (*Generator, error) {
+	cfg := NewConfig(o...)
+	if len(cfg.lists) == 0 {
+		return nil, ErrNoWords
+	}
+	for _, list := range cfg.lists {
+		if len(list) == 0 {
+			return nil, ErrNoWords
+		}
+	}
+	if cfg.digits > MaxSuffixDigits {
+		return nil, fmt.Errorf("%w: %d digits, at most %d", ErrSuffixDigits, cfg.digits, MaxSuffixDigits)
+	}
+	return &Generator{cfg: cfg, rng: random.NewRand(cfg.random...)}, nil
+}
+
+// maxRandomAttempts is how many random draws are made before scanning for a free name.
+const maxRandomAttempts = 32
+
+// Name returns a random name, or ErrExhausted if every combination is excluded.
+func (g *Generator) Name() (string, error) {
+	return g.next(func(string) bool { return true })
+}
+
+// next returns a random name accepted by free. Random draws are tried first;
+// when they keep failing the name space is scanned from a random position, so
+// a name is always found while one exists.
+func (g *Generator) next(free func(name string) bool) (string, error) {
+	for attempt := 0; attempt < maxRandomAttempts; attempt++ {
+		words := make([]string, len(g.cfg.lists))
+		g.mu.Lock()
+		for i, list := range g.cfg.lists {
+			words[i] = list[g.rng.IntN(len(list))]
+		}
+		suffix := g.suffix()
+		g.mu.Unlock()
+
+		if g.cfg.exclude[strings.Join(words, "\x00")] {
+			continue
+		}
+		if name := strings.Join(words, g.cfg.separator) + suffix; free(name) {
+			return name, nil
+		}
+	}
+
+	size, ok := g.space()
+	if !ok {
+		return "", ErrExhausted
+	}
+
+	g.mu.Lock()
+	start := g.rng.Uint64N(size)
+	g.mu.Unlock()
+
+	for i := uint64(0); i < size; i++ {
+		if name, ok := g.nameAt((start + i) % size); ok && free(name) {
+			return name, nil
+		}
+	}
+	return "", ErrExhausted
+}
+
+func (g *Generator) suffix() string {
+	if g.cfg.digits == 0 {
+		return ""
+	}
+	n := g.rng.Int64N(pow10(g.cfg.digits))
+	s := strconv.FormatInt(n, 10)
+	return strings.Repeat("0", g.cfg.digits-len(s)) + s
+}
+
+// Capacity returns the number of distinct names the generator can produce.
+func (g *Generator) Capacity() *big.Int {
+	c := big.NewInt(1)
+	for _, list := range g.cfg.lists {
+		c.Mul(c, big.NewInt(int64(len(list))))
+	}
+	c.Sub(c, big.NewInt(int64(g.excludedInSpace())))
+	return c.Mul(c, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(g.cfg.digits)), nil))
+}
+
+// excludedInSpace counts the exclusions that are actual combinations of the lists.
+func (g *Generator) excludedInSpace() int {
+	n := 0
+	for key := range g.cfg.exclude {
+		words := strings.Split(key, "\x00")
+		if len(words) == len(g.cfg.lists) && g.inLists(words) {
+			n++
+		}
+	}
+	return n
+}
+
+func (g *Generator) inLists(words []string) bool {
+	for i, w := range words {
+		found := false
+		for _, candidate := range g.cfg.lists[i] {
+			if candidate == w {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// nameAt returns the name with the given index in [0, Capacity) before exclusions,
+// treating the words and suffix as digits of a mixed-radix number.
+// The second result is false if the combination is excluded.
+func (g *Generator) nameAt(index uint64) (string, bool) {
+	var suffix string
+	if g.cfg.digits > 0 {
+		p := uint64(pow10(g.cfg.digits))
+		s := strconv.FormatUint(index%p, 10)
+		suffix = strings.Repeat("0", g.cfg.digits-len(s)) + s
+		index /= p
+	}
+
+	words := make([]string, len(g.cfg.lists))
+	for i := len(g.cfg.lists) - 1; i >= 0; i-- {
+		n := uint64(len(g.cfg.lists[i]))
+		words[i] = g.cfg.lists[i][index%n]
+		index /= n
+	}
+
+	if g.cfg.exclude[strings.Join(words, "\x00")] {
+		return "", false
+	}
+	return strings.Join(words, g.cfg.separator) + suffix, true
+}
+
+// produces reports whether name is one of the names the generator can hand out.
+func (g *Generator) produces(name string) bool {
+	if d := g.cfg.digits; d > 0 {
+		if len(name) < d {
+			return false
+		}
+		for _, c := range name[len(name)-d:] {
+			if c < '0' || c > '9' {
+				return false
+			}
+		}
+		name = name[:len(name)-d]
+	}
+	return g.matchWords(name, make([]string, 0, len(g.cfg.lists)))
+}
+
+// matchWords reports whether rest splits into words of the remaining lists
+// forming a combination that is not excluded. Every split is tried, as words
+// may contain the separator.
+func (g *Generator) matchWords(rest string, words []string) bool {
+	i := len(words)
+	if i == len(g.cfg.lists) {
+		return rest == "" && !g.cfg.exclude[strings.Join(words, "\x00")]
+	}
+	if i > 0 {
+		var ok bool
+		if rest, ok = strings.CutPrefix(rest, g.cfg.separator); !ok {
+			return false
+		}
+	}
+	for _, w := range g.cfg.lists[i] {
+		if after, ok := strings.CutPrefix(rest, w); ok && g.matchWords(after, append(words, w)) {
+			return true
+		}
+	}
+	return false
+}
+
+// space returns the size of the index space used by nameAt, and false if it does not fit in a uint64.
+func (g *Generator) space() (uint64, bool) {
+	c := big.NewInt(1)
+	for _, list := range g.cfg.lists {
+		c.Mul(c, big.NewInt(int64(len(list))))
+	}
+	c.Mul(c, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(g.cfg.digits)), nil))
+	return c.Uint64(), c.IsUint64()
+}
+
+func pow10(n int) int64 {
+	p := int64(1)
+	for i := 0; i < n; i++ {
+		p *= 10
+	}
+	return p
+}
+
+// Tracker hands out names that are unique within a namespace. It is safe for concurrent use.
+type Tracker struct {
+	gen *Generator
+	mu  sync.Mutex
+	// seen holds the names taken in each namespace
+	seen map[string]map[string]struct{}
+}
+
+// NewTracker returns a Tracker drawing names from gen.
+func NewTracker(gen *Generator) *Tracker {
+	return &Tracker{gen: gen, seen: make(map[string]map[string]struct{})}
+}
+
+// Next returns a name not yet taken in namespace and marks it taken, or
+// ErrExhausted once every name is taken.
+func (t *Tracker) Next(namespace string) (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	taken := t.seen[namespace]
+	if taken == nil {
+		taken = make(map[string]struct{})
+		t.seen[namespace] = taken
+	}
+
+	capacity := t.gen.Capacity()
+	if capacity.IsInt64() && int64(len(taken)) >= capacity.Int64() {
+		return "", ErrExhausted
+	}
+
+	name, err := t.gen.next(func(name string) bool {
+		_, used := taken[name]
+		return !used
+	})
+	if err != nil {
+		return "", err
+	}
+	taken[name] = struct{}{}
+	return name, nil
+}
+
+// Reserve marks name as taken in namespace, e.g. for names created before the tracker.
+// It reports false if the name was already taken or is not a name the generator
+// can produce, so every taken name counts against the Capacity.
+func (t *Tracker) Reserve(namespace, name string) bool {
+	if !t.gen.produces(name) {
+		return false
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	taken := t.seen[namespace]
+	if taken == nil {
+		taken = make(map[string]struct{})
+		t.seen[namespace] = taken
+	}
+	if _, ok := taken[name]; ok {
+		return false
+	}
+	taken[name] = struct{}{}
+	return true
+}
+
+// Release frees a name so it can be handed out again.
+func (t *Tracker) Release(namespace, name string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.seen[namespace], name)
+}
+
+// Len returns how many names are taken in namespace.
+func (t *Tracker) Len(namespace string) int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return len(t.seen[namespace])
+}
+
+// Remaining returns how many names are still free in namespace.
+func (t *Tracker) Remaining(namespace string) *big.Int {
+	return new(big.Int).Sub(t.gen.Capacity(), big.NewInt(int64(t.Len(namespace))))
+}
+
+// Reset forgets every name taken in namespace.
+func (t *Tracker) Reset(namespace string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.seen, namespace)
+}
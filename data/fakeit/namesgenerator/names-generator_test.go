@@ -1,8 +1,14 @@
 package namesgenerator // import "github.com/docker/docker/pkg/namesgenerator"
 
 import (
+	"errors"
+	"math/big"
+	"regexp"
 	"strings"
 	"testing"
+
+	"github.com/inovacc/toolkit/data/algorithm/random"
+	"github.com/inovacc/toolkit/data/mnemonic"
 )
 
 func TestNameFormat(t *testing.T) {
@@ -33,3 +39,167 @@ func BenchmarkGetRandomName(b *testing.B) {
 	}
 	b.Log("Last result:", out)
 }
+
+func mustNew(t *testing.T, o ...OptsFn) *Generator {
+	t.Helper()
+	g, err := New(o...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return g
+}
+
+func mustName(t *testing.T, g *Generator) string {
+	t.Helper()
+	name, err := g.Name()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return name
+}
+
+func TestGeneratorSeeded(t *testing.T) {
+	a := mustNew(t, WithRandom(random.WithSeed(7)))
+	b := mustNew(t, WithRandom(random.WithSeed(7)))
+	for i := 0; i < 10; i++ {
+		if x, y := mustName(t, a), mustName(t, b); x != y {
+			t.Fatalf("same seed produced %q and %q", x, y)
+		}
+	}
+}
+
+func TestGeneratorOptions(t *testing.T) {
+	g := mustNew(t,
+		WithMnemonicWords(mnemonic.English, 3),
+		WithSeparator("-"),
+		WithSuffix(3),
+		WithRandom(random.WithSeed(1)),
+	)
+
+	re := regexp.MustCompile(`^[a-z]+-[a-z]+-[a-z]+\d{3}$`)
+	for i := 0; i < 100; i++ {
+		if name := mustName(t, g); !re.MatchString(name) {
+			t.Fatalf("unexpected name %q", name)
+		}
+	}
+
+	want := new(big.Int).Mul(big.NewInt(2048*2048*2048), big.NewInt(1000))
+	if c := g.Capacity(); c.Cmp(want) != 0 {
+		t.Errorf("Capacity() = %s, want %s", c, want)
+	}
+}
+
+func TestGeneratorCapacity(t *testing.T) {
+	// The default lists exclude boring_wozniak
+	want := int64(len(left)*len(right) - 1)
+	if c := mustNew(t).Capacity(); c.Int64() != want {
+		t.Errorf("Capacity() = %s, want %d", c, want)
+	}
+
+	// Exclusions survive a later WithWordLists
+	g := mustNew(t, WithExclude("a", "x"), WithWordLists([]string{"a", "b"}, []string{"x", "y"}), WithExclude("c", "z"))
+	if c := g.Capacity(); c.Int64() != 3 {
+		t.Errorf("Capacity() = %s, want 3", c)
+	}
+}
+
+func TestGeneratorProduces(t *testing.T) {
+	// Words containing the separator are split at every position
+	g := mustNew(t, WithWordLists([]string{"a", "a_b"}, []string{"b_c", "c"}), WithExclude("a", "b_c"))
+	for name, want := range map[string]bool{"a_b_c": true, "a_c": true, "a_b_b_c": true, "a_b": false, "b_c": false} {
+		if got := g.produces(name); got != want {
+			t.Errorf("produces(%q) = %v, want %v", name, got, want)
+		}
+	}
+}
+
+func TestGeneratorErrors(t *testing.T) {
+	for name, opts := range map[string][]OptsFn{
+		"no lists":         {WithWordLists()},
+		"empty list":       {WithWordLists([]string{"a"}, nil)},
+		"unknown language": {WithMnemonicWords("Klingon", 2)},
+		"no words":         {WithMnemonicWords(mnemonic.English, 0)},
+		"negative count":   {WithMnemonicWords(mnemonic.English, -1)},
+	} {
+		if _, err := New(opts...); !errors.Is(err, ErrNoWords) {
+			t.Errorf("%s: New() error = %v, want ErrNoWords", name, err)
+		}
+	}
+
+	if _, err := New(WithSuffix(MaxSuffixDigits + 1)); !errors.Is(err, ErrSuffixDigits) {
+		t.Errorf("New(WithSuffix(19)) error = %v, want ErrSuffixDigits", err)
+	}
+	if name := mustName(t, mustNew(t, WithSuffix(MaxSuffixDigits))); len(name) < MaxSuffixDigits {
+		t.Errorf("unexpected name %q", name)
+	}
+
+	// Every combination excluded
+	g := mustNew(t, WithWordLists([]string{"a"}, []string{"x"}), WithExclude("a", "x"))
+	if _, err := g.Name(); !errors.Is(err, ErrExhausted) {
+		t.Errorf("Name() error = %v, want ErrExhausted", err)
+	}
+
+	// A single free combination is still found
+	g = mustNew(t, WithWordLists([]string{"a", "b"}, []string{"x"}), WithExclude("a", "x"), WithRandom(random.WithSeed(1)))
+	for i := 0; i < 10; i++ {
+		if name := mustName(t, g); name != "b_x" {
+			t.Fatalf("Name() = %q, want b_x", name)
+		}
+	}
+}
+
+func TestTracker(t *testing.T) {
+	g := mustNew(t,
+		WithWordLists([]string{"red", "green", "blue"}, []string{"fox", "owl"}),
+		WithExclude("blue", "owl"),
+		WithSuffix(1),
+		WithRandom(random.WithSeed(3)),
+	)
+	tr := NewTracker(g)
+
+	if !tr.Reserve("ns", "red_fox0") || tr.Reserve("ns", "red_fox0") {
+		t.Fatal("Reserve did not track the name")
+	}
+	for _, name := range []string{"web-01", "red_fox", "red_foxx", "red_fox00", "blue_owl3", "red__fox0"} {
+		if tr.Reserve("ns", name) {
+			t.Errorf("Reserve(%q) accepted a name outside the generator", name)
+		}
+	}
+	if tr.Len("ns") != 1 {
+		t.Fatalf("Len() = %d, want 1", tr.Len("ns"))
+	}
+
+	seen := map[string]bool{"red_fox0": true}
+	for i := int64(1); i < g.Capacity().Int64(); i++ {
+		name, err := tr.Next("ns")
+		if err != nil {
+			t.Fatalf("Next() failed after %d names: %v", i, err)
+		}
+		if seen[name] || strings.HasPrefix(name, "blue_owl") {
+			t.Fatalf("Next() returned %q", name)
+		}
+		seen[name] = true
+	}
+
+	if _, err := tr.Next("ns"); !errors.Is(err, ErrExhausted) {
+		t.Errorf("expected ErrExhausted, got %v", err)
+	}
+	if tr.Remaining("ns").Sign() != 0 {
+		t.Errorf("Remaining() = %s, want 0", tr.Remaining("ns"))
+	}
+
+	// Namespaces are independent
+	if _, err := tr.Next("other"); err != nil {
+		t.Errorf("Next() on a new namespace failed: %v", err)
+	}
+
+	tr.Release("ns", "green_owl5")
+	if name, err := tr.Next("ns"); err != nil || name != "green_owl5" {
+		t.Errorf("Next() after Release = %q, %v", name, err)
+	}
+
+	tr.Reset("ns")
+	if tr.Len("ns") != 0 {
+		t.Errorf("Len() after Reset = %d", tr.Len("ns"))
+	}
+}
@@ -1,105 +1,443 @@
 package main
 
 import (
+	"bufio"
 	"bytes"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
+	"io"
 	"os"
+	"runtime"
 	"strings"
 	"text/template"
 
 	"github.com/inovacc/toolkit/data/mnemonic"
+	"github.com/inovacc/toolkit/data/mnemonic/entropy"
+	"golang.org/x/term"
 )
 
-var (
-	count   int
-	lang    string
-	format  string
-	tpl     string
-	verbose bool
-)
+const usage = `Usage:
+  mnemonic [flags]                      generate mnemonics (same as "mnemonic generate")
+  mnemonic generate [flags]             generate mnemonics
+  mnemonic validate [flags] [sentence]  check the words and checksum of a mnemonic
+  mnemonic seed [flags] [sentence]      derive the BIP-39 seed of a mnemonic
+  mnemonic entropy [flags] <hex>        build the mnemonic for the given entropy
+  mnemonic languages                    list the supported languages
+
+When the sentence is omitted it is read from standard input.
+Run "mnemonic <command> -h" for the flags of a command.
+`
+
+// result is what a command prints; Entropy and Seed are hex encoded.
+type result struct {
+	Words    []string `json:"words"`
+	Sentence string   `json:"sentence"`
+	Language string   `json:"language"`
+	Entropy  string   `json:"entropy"`
+	Seed     string   `json:"seed,omitempty"`
+}
+
+// ttyPath returns the controlling terminal, which is read instead of standard
+// input so a passphrase can be prompted while the sentence is piped in.
+func ttyPath() string {
+	if runtime.GOOS == "windows" {
+		return "CONIN$"
+	}
+	return "/dev/tty"
+}
+
+// readPassword prompts on the terminal without echoing the input.
+var readPassword = func(prompt string, stderr io.Writer) (string, error) {
+	tty, err := os.OpenFile(ttyPath(), os.O_RDWR, 0)
+	if err != nil {
+		return "", fmt.Errorf("cannot prompt for a passphrase: no terminal: %w", err)
+	}
+	defer func() { _ = tty.Close() }()
 
-func init() {
-	flag.IntVar(&count, "n", 1, "Number of mnemonics to generate.")
-	flag.StringVar(&lang, "lang", "English", "Language for the mnemonic words.")
-	flag.StringVar(&format, "f", "sentence", "Format: sentence, words, json, raw, or template.")
-	flag.StringVar(&tpl, "t", "", "Go template used to format the output (used with -f=template).")
-	flag.BoolVar(&verbose, "v", false, "Enable verbose output.")
+	_, _ = fmt.Fprint(stderr, prompt)
+	b, err := term.ReadPassword(int(tty.Fd()))
+	_, _ = fmt.Fprintln(stderr)
+	return string(b), err
 }
 
 func main() {
-	flag.Parse()
+	if code := run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr); code != 0 {
+		os.Exit(code)
+	}
+}
+
+// run executes the command line and returns the exit code.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	cmd := "generate"
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		cmd, args = args[0], args[1:]
+	}
+
+	commands := map[string]func([]string, io.Reader, io.Writer, io.Writer) error{
+		"generate":  generate,
+		"validate":  validate,
+		"seed":      seed,
+		"entropy":   fromEntropy,
+		"languages": languages,
+	}
+
+	fn, ok := commands[cmd]
+	if !ok {
+		_, _ = fmt.Fprintf(stderr, "Unknown command: %s\n\n%s", cmd, usage)
+		return 2
+	}
+
+	if err := fn(args, stdin, stdout, stderr); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		_, _ = fmt.Fprintf(stderr, "Error: %v\n", err)
+		var usage *usageError
+		if errors.As(err, &usage) {
+			return 2
+		}
+		return 1
+	}
+	return 0
+}
+
+// usageError reports an invalid flag value; run exits with status 2 for it.
+type usageError struct {
+	msg string
+}
+
+func (e *usageError) Error() string {
+	return e.msg
+}
+
+// outputFlags registers the flags shared by the commands that print a mnemonic.
+type outputFlags struct {
+	lang   string
+	format string
+	tpl    string
+}
+
+func newFlagSet(name string, stderr io.Writer) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		_, _ = fmt.Fprint(stderr, usage)
+		_, _ = fmt.Fprintf(stderr, "\nFlags of %s:\n", name)
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+func (o *outputFlags) register(fs *flag.FlagSet, lang, format string) {
+	fs.StringVar(&o.lang, "lang", lang, "Language for the mnemonic words, see \"mnemonic languages\".")
+	fs.StringVar(&o.format, "f", format, "Format: sentence, words, json, raw, entropy, seed, or template.")
+	fs.StringVar(&o.tpl, "t", "", "Go template used to format the output (used with -f=template).\nFields: .Words .Sentence .Language .Entropy .Seed")
+}
 
-	language := mnemonic.LanguageStr(lang)
-	formats := map[string]func(m *mnemonic.Mnemonic){
+func (o *outputFlags) print(w io.Writer, r result) error {
+	formats := map[string]func(io.Writer, result) error{
 		"sentence": printSentence,
 		"words":    printWords,
 		"json":     printJSON,
 		"raw":      printRaw,
-		"template": printTemplate,
+		"entropy":  printEntropy,
+		"seed":     printSeed,
+		"template": func(w io.Writer, r result) error { return printTemplate(w, o.tpl, r) },
 	}
 
-	formatFn, ok := formats[format]
+	fn, ok := formats[o.format]
 	if !ok {
-		fmt.Printf("Unsupported format: %s\n", format)
-		flag.PrintDefaults()
-		os.Exit(1)
+		return fmt.Errorf("unsupported format: %s", o.format)
+	}
+	return fn(w, r)
+}
+
+func newResult(m *mnemonic.Mnemonic) (result, error) {
+	ent, err := m.Entropy()
+	if err != nil {
+		return result{}, err
+	}
+	return result{
+		Words:    m.Words,
+		Sentence: m.Sentence(),
+		Language: string(m.Language),
+		Entropy:  hex.EncodeToString(ent),
+	}, nil
+}
+
+// bitLength resolves the -words and -bits flags; -bits wins when both are set.
+func bitLength(words, bits int) (int, error) {
+	if bits == 0 {
+		if words < 12 || words > 24 || words%3 != 0 {
+			return 0, fmt.Errorf("invalid word count %d: use 12, 15, 18, 21 or 24", words)
+		}
+		bits = words / 3 * 32
+	}
+	if bits < 128 || bits > 256 || bits%32 != 0 {
+		return 0, fmt.Errorf("invalid bit length %d: use 128, 160, 192, 224 or 256", bits)
+	}
+	return bits, nil
+}
+
+func generate(args []string, _ io.Reader, stdout, stderr io.Writer) error {
+	var (
+		out     outputFlags
+		count   int
+		words   int
+		bits    int
+		verbose bool
+	)
+
+	fs := newFlagSet("generate", stderr)
+	out.register(fs, "English", "sentence")
+	fs.IntVar(&count, "n", 1, "Number of mnemonics to generate.")
+	fs.IntVar(&words, "words", 12, "Number of words: 12, 15, 18, 21 or 24.")
+	fs.IntVar(&bits, "bits", 0, "Entropy bit length: 128, 160, 192, 224 or 256. Overrides -words.")
+	fs.BoolVar(&verbose, "v", false, "Enable verbose output.")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	size, err := bitLength(words, bits)
+	if err != nil {
+		return err
+	}
+	lang, err := parseLanguage(out.lang, false)
+	if err != nil {
+		return err
 	}
 
 	for i := 0; i < count; i++ {
-		m, err := mnemonic.NewRandom(128, language)
+		m, err := mnemonic.NewRandom(size, lang)
+		if err != nil {
+			return fmt.Errorf("generating mnemonic: %w", err)
+		}
+		r, err := newResult(m)
 		if err != nil {
-			fmt.Printf("Error generating mnemonic: %v\n", err)
-			os.Exit(1)
+			return err
 		}
 		if verbose {
-			fmt.Printf("Mnemonic #%d:\n", i+1)
+			_, _ = fmt.Fprintf(stdout, "Mnemonic #%d:\n", i+1)
+		}
+		if err := out.print(stdout, r); err != nil {
+			return err
 		}
-		formatFn(m)
 	}
+	return nil
 }
 
-func printSentence(m *mnemonic.Mnemonic) {
-	fmt.Println(m.Sentence())
+// readSentence returns the sentence from the arguments, or from the first line of stdin.
+func readSentence(args []string, stdin io.Reader) (string, error) {
+	if len(args) > 0 {
+		return strings.Join(args, " "), nil
+	}
+
+	line, err := bufio.NewReader(stdin).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", err
+	}
+	if strings.TrimSpace(line) == "" {
+		return "", errors.New("no mnemonic given")
+	}
+	return line, nil
 }
 
-func printWords(m *mnemonic.Mnemonic) {
-	for _, w := range m.Words {
-		fmt.Println(w)
+// parseLanguage matches the -lang flag case-insensitively against the supported
+// languages. When auto is true, "auto" is accepted and returns "" to detect it.
+func parseLanguage(lang string, auto bool) (mnemonic.LanguageStr, error) {
+	if auto && strings.EqualFold(lang, "auto") {
+		return "", nil
 	}
+
+	var names []string
+	if auto {
+		names = append(names, "auto")
+	}
+	for _, l := range mnemonic.Languages() {
+		if strings.EqualFold(lang, string(l)) {
+			return l, nil
+		}
+		names = append(names, string(l))
+	}
+	return "", &usageError{fmt.Sprintf("unknown language %q: use one of %s", lang, strings.Join(names, ", "))}
 }
 
-func printJSON(m *mnemonic.Mnemonic) {
-	out := map[string]any{
-		"language": m.Language,
-		"words":    m.Words,
-		"sentence": m.Sentence(),
+func validate(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	var name string
+	fs := newFlagSet("validate", stderr)
+	fs.StringVar(&name, "lang", "auto", "Language of the mnemonic, or auto to detect it.")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	lang, err := parseLanguage(name, true)
+	if err != nil {
+		return err
+	}
+
+	sentence, err := readSentence(fs.Args(), stdin)
+	if err != nil {
+		return err
+	}
+
+	m, err := mnemonic.Parse(sentence, lang)
+	if err != nil {
+		return err
 	}
-	data, _ := json.MarshalIndent(out, "", "  ")
-	fmt.Println(string(data))
+
+	_, _ = fmt.Fprintf(stdout, "valid: %s, %d words, %d bits of entropy\n", m.Language, len(m.Words), len(m.Words)/3*32)
+	return nil
 }
 
-func printRaw(m *mnemonic.Mnemonic) {
-	fmt.Println(strings.Join(m.Words, ","))
+func seed(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	var (
+		out    outputFlags
+		prompt bool
+	)
+
+	fs := newFlagSet("seed", stderr)
+	out.register(fs, "auto", "seed")
+	fs.BoolVar(&prompt, "p", false, "Prompt for a BIP-39 passphrase on the terminal, without echo.")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	lang, err := parseLanguage(out.lang, true)
+	if err != nil {
+		return err
+	}
+
+	sentence, err := readSentence(fs.Args(), stdin)
+	if err != nil {
+		return err
+	}
+
+	m, err := mnemonic.Parse(sentence, lang)
+	if err != nil {
+		return err
+	}
+
+	var passphrase string
+	if prompt {
+		if passphrase, err = readPassword("Passphrase: ", stderr); err != nil {
+			return err
+		}
+		confirm, err := readPassword("Confirm passphrase: ", stderr)
+		if err != nil {
+			return err
+		}
+		if confirm != passphrase {
+			return errors.New("passphrases do not match")
+		}
+	}
+
+	r, err := newResult(m)
+	if err != nil {
+		return err
+	}
+	r.Seed = m.GenerateSeed(passphrase).ToHex()
+	return out.print(stdout, r)
 }
 
-func printTemplate(m *mnemonic.Mnemonic) {
+func fromEntropy(args []string, _ io.Reader, stdout, stderr io.Writer) error {
+	var out outputFlags
+	fs := newFlagSet("entropy", stderr)
+	out.register(fs, "English", "sentence")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if fs.NArg() != 1 {
+		return errors.New("expected one hex-encoded entropy argument")
+	}
+
+	ent, err := entropy.FromHex(strings.TrimSpace(fs.Arg(0)))
+	if err != nil {
+		return fmt.Errorf("invalid hex entropy: %w", err)
+	}
+
+	lang, err := parseLanguage(out.lang, false)
+	if err != nil {
+		return err
+	}
+	m, err := mnemonic.New(ent, lang)
+	if err != nil {
+		return err
+	}
+
+	r, err := newResult(m)
+	if err != nil {
+		return err
+	}
+	return out.print(stdout, r)
+}
+
+func languages(args []string, _ io.Reader, stdout, stderr io.Writer) error {
+	fs := newFlagSet("languages", stderr)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	for _, lang := range mnemonic.Languages() {
+		_, _ = fmt.Fprintln(stdout, lang)
+	}
+	return nil
+}
+
+func printSentence(w io.Writer, r result) error {
+	_, err := fmt.Fprintln(w, r.Sentence)
+	return err
+}
+
+func printWords(w io.Writer, r result) error {
+	_, err := fmt.Fprintln(w, strings.Join(r.Words, "\n"))
+	return err
+}
+
+func printJSON(w io.Writer, r result) error {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, string(data))
+	return err
+}
+
+func printRaw(w io.Writer, r result) error {
+	_, err := fmt.Fprintln(w, strings.Join(r.Words, ","))
+	return err
+}
+
+func printEntropy(w io.Writer, r result) error {
+	_, err := fmt.Fprintln(w, r.Entropy)
+	return err
+}
+
+func printSeed(w io.Writer, r result) error {
+	if r.Seed == "" {
+		return errors.New("no seed computed: use the seed command")
+	}
+	_, err := fmt.Fprintln(w, r.Seed)
+	return err
+}
+
+func printTemplate(w io.Writer, tpl string, r result) error {
 	if tpl == "" {
-		_, _ = fmt.Fprintln(os.Stderr, "Missing -t template string")
-		os.Exit(1)
+		return errors.New("missing -t template string")
+	}
+	t, err := template.New("").Parse(tpl)
+	if err != nil {
+		return err
 	}
-	t := template.Must(template.New("").Parse(tpl))
+
 	buf := &bytes.Buffer{}
-	_ = t.Execute(buf, struct {
-		Words    []string
-		Sentence string
-		Language string
-	}{
-		Words:    m.Words,
-		Sentence: m.Sentence(),
-		Language: string(m.Language),
-	})
+	if err := t.Execute(buf, r); err != nil {
+		return err
+	}
 	buf.WriteByte('\n')
-	fmt.Print(buf.String())
+	_, err = w.Write(buf.Bytes())
+	return err
 }
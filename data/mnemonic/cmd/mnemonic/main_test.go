@@ -1,10 +1,15 @@
 package main
 
 import (
+	"bytes"
+	"io"
 	"os"
+	"strings"
 	"testing"
 )
 
+const vector = "legal winner thank year wave sausage worth useful legal winner thank yellow"
+
 func TestMain_CLI(t *testing.T) {
 	os.Args = []string{"cmd", "-n", "1", "-lang=English", "-f=sentence"}
 	main()
@@ -14,3 +19,140 @@ func TestMain_Template(t *testing.T) {
 	os.Args = []string{"cmd", "-n", "1", "-f=template", "-t=mnemonic: {{.Sentence}}"}
 	main()
 }
+
+func runCLI(t *testing.T, stdin string, args ...string) (string, string, int) {
+	t.Helper()
+	var stdout, stderr bytes.Buffer
+	code := run(args, strings.NewReader(stdin), &stdout, &stderr)
+	return stdout.String(), stderr.String(), code
+}
+
+func TestGenerateWords(t *testing.T) {
+	for _, args := range [][]string{{"-words", "24"}, {"generate", "-bits", "256"}} {
+		out, stderr, code := runCLI(t, "", args...)
+		if code != 0 {
+			t.Fatalf("%v: exit %d: %s", args, code, stderr)
+		}
+		if n := len(strings.Fields(out)); n != 24 {
+			t.Errorf("%v: got %d words", args, n)
+		}
+	}
+
+	if _, _, code := runCLI(t, "", "-words", "13"); code != 1 {
+		t.Errorf("-words 13: exit %d; want 1", code)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	out, _, code := runCLI(t, "", "validate", vector)
+	if code != 0 || !strings.Contains(out, "English, 12 words, 128 bits") {
+		t.Errorf("validate = %q, exit %d", out, code)
+	}
+
+	// From stdin, with a misspelled word
+	_, stderr, code := runCLI(t, strings.Replace(vector, "sausage", "sausge", 1)+"\n", "validate")
+	if code != 1 || !strings.Contains(stderr, "sausage") {
+		t.Errorf("validate stderr = %q, exit %d", stderr, code)
+	}
+}
+
+func TestSeed(t *testing.T) {
+	want := "878386efb78845b3355bd15ea4d39ef97d179cb712b77d5c12b6be415fffeffe5f377ba02bf3f8544ab800b955e51fbff09828f682052a20faa6addbbddfb096"
+
+	out, stderr, code := runCLI(t, "", "seed", vector)
+	if code != 0 || strings.TrimSpace(out) != want {
+		t.Errorf("seed = %q, exit %d: %s", out, code, stderr)
+	}
+
+	out, _, _ = runCLI(t, "", "seed", "-f=json", vector)
+	if !strings.Contains(out, `"seed": "`+want+`"`) || !strings.Contains(out, `"entropy": "7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f"`) {
+		t.Errorf("seed -f=json = %s", out)
+	}
+}
+
+func TestSeedPassphrase(t *testing.T) {
+	defer func(orig func(string, io.Writer) (string, error)) { readPassword = orig }(readPassword)
+
+	readPassword = func(string, io.Writer) (string, error) { return "TREZOR", nil }
+	out, _, code := runCLI(t, "", "seed", "-p", "-f=template", "-t={{.Seed}}", vector)
+	want := "2e8905819b8723fe2c1d161860e5ee1830318dbf49a83bd451cfb8440c28bd6fa457fe1296106559a3c80937a1c1069be3a3a5bd381ee6260e8d9739fce1f607"
+	if code != 0 || strings.TrimSpace(out) != want {
+		t.Errorf("seed -p = %q, exit %d", out, code)
+	}
+
+	answers := []string{"one", "two"}
+	readPassword = func(string, io.Writer) (string, error) {
+		a := answers[0]
+		answers = answers[1:]
+		return a, nil
+	}
+	if _, stderr, code := runCLI(t, "", "seed", "-p", vector); code != 1 || !strings.Contains(stderr, "do not match") {
+		t.Errorf("mismatched passphrases: exit %d: %s", code, stderr)
+	}
+}
+
+func TestSeedPassphrasePipedSentence(t *testing.T) {
+	defer func(orig func(string, io.Writer) (string, error)) { readPassword = orig }(readPassword)
+
+	// The sentence comes from stdin, so the passphrase must not be read from it
+	var prompts []string
+	readPassword = func(prompt string, _ io.Writer) (string, error) {
+		prompts = append(prompts, prompt)
+		return "TREZOR", nil
+	}
+
+	out, stderr, code := runCLI(t, vector+"\n", "seed", "-p", "-f=json")
+	want := "2e8905819b8723fe2c1d161860e5ee1830318dbf49a83bd451cfb8440c28bd6fa457fe1296106559a3c80937a1c1069be3a3a5bd381ee6260e8d9739fce1f607"
+	if code != 0 || !strings.Contains(out, `"seed": "`+want+`"`) {
+		t.Errorf("seed -p -f=json < sentence = %s, exit %d: %s", out, code, stderr)
+	}
+	if len(prompts) != 2 {
+		t.Errorf("prompted %d times, want 2", len(prompts))
+	}
+}
+
+func TestEntropy(t *testing.T) {
+	out, _, code := runCLI(t, "", "entropy", "7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f")
+	if code != 0 || strings.TrimSpace(out) != vector {
+		t.Errorf("entropy = %q, exit %d", out, code)
+	}
+
+	if _, _, code := runCLI(t, "", "entropy", "zz"); code != 1 {
+		t.Errorf("invalid hex: exit %d; want 1", code)
+	}
+}
+
+func TestLanguages(t *testing.T) {
+	out, _, code := runCLI(t, "", "languages")
+	if code != 0 || !strings.Contains(out, "Japanese\n") || len(strings.Fields(out)) != 10 {
+		t.Errorf("languages = %q, exit %d", out, code)
+	}
+
+	if _, _, code := runCLI(t, "", "frobnicate"); code != 2 {
+		t.Errorf("unknown command: exit %d; want 2", code)
+	}
+}
+
+func TestLanguageFlag(t *testing.T) {
+	out, stderr, code := runCLI(t, "", "-lang", "english")
+	if code != 0 || len(strings.Fields(out)) != 12 {
+		t.Errorf("-lang english = %q, exit %d: %s", out, code, stderr)
+	}
+
+	out, _, code = runCLI(t, "", "validate", "-lang", "ENGLISH", vector)
+	if code != 0 || !strings.Contains(out, "valid") {
+		t.Errorf("validate -lang ENGLISH = %q, exit %d", out, code)
+	}
+
+	for _, args := range [][]string{
+		{"-lang", "auto"},
+		{"entropy", "-lang", "Klingon", "00000000000000000000000000000000"},
+		{"validate", "-lang", "klingon", vector},
+		{"seed", "-lang", "klingon", vector},
+	} {
+		_, stderr, code := runCLI(t, "", args...)
+		if code != 2 || !strings.Contains(stderr, "unknown language") || !strings.Contains(stderr, "English, Spanish") {
+			t.Errorf("%v: exit %d: %s", args, code, stderr)
+		}
+	}
+}
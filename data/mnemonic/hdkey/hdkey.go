@@ -0,0 +1,348 @@
+// Package hdkey implements BIP-32 hierarchical deterministic keys and their
+// SLIP-10 generalization, deriving secp256k1 or ed25519 key trees from a seed.
+package hdkey
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/inovacc/toolkit/data/mnemonic"
+	"github.com/inovacc/toolkit/data/serde/encoder"
+	"golang.org/x/crypto/ripemd160" //nolint:staticcheck // BIP-32 fingerprints are defined with RIPEMD-160
+)
+
+// HardenedOffset is added to a child index to request hardened derivation.
+const HardenedOffset uint32 = 0x80000000
+
+// Curve selects the elliptic curve of a key tree.
+type Curve int
+
+const (
+	// Secp256k1 is the BIP-32 curve used by Bitcoin and Ethereum.
+	Secp256k1 Curve = iota
+	// Ed25519 follows SLIP-10; it only supports hardened derivation.
+	Ed25519
+)
+
+func (c Curve) String() string {
+	switch c {
+	case Secp256k1:
+		return "secp256k1"
+	case Ed25519:
+		return "ed25519"
+	default:
+		return fmt.Sprintf("Curve(%d)", int(c))
+	}
+}
+
+// hmacKey returns the SLIP-10 master key HMAC key for the curve.
+func (c Curve) hmacKey() []byte {
+	if c == Ed25519 {
+		return []byte("ed25519 seed")
+	}
+	return []byte("Bitcoin seed")
+}
+
+// Extended key version bytes for mainnet.
+var (
+	versionPrivate = [4]byte{0x04, 0x88, 0xad, 0xe4} // xprv
+	versionPublic  = [4]byte{0x04, 0x88, 0xb2, 0x1e} // xpub
+)
+
+var (
+	ErrSeedLength       = errors.New("hdkey: seed must be between 16 and 64 bytes")
+	ErrHardenedPublic   = errors.New("hdkey: cannot derive a hardened child from a public key")
+	ErrNonHardened      = errors.New("hdkey: ed25519 only supports hardened derivation")
+	ErrPublicDerivation = errors.New("hdkey: ed25519 public keys cannot derive children")
+	ErrMaxDepth         = errors.New("hdkey: maximum depth of 255 reached")
+	ErrInvalidKey       = errors.New("hdkey: invalid extended key")
+	ErrChecksum         = errors.New("hdkey: invalid extended key checksum")
+)
+
+// Key is an extended private or public key: a key plus the chain code and
+// position needed to derive its children.
+type Key struct {
+	curve     Curve
+	key       []byte // 32-byte private key, or 33-byte public key
+	chainCode []byte
+	depth     uint8
+	parentFP  [4]byte
+	child     uint32
+	private   bool
+}
+
+// NewMasterKey derives the master key of a tree from a 16 to 64 byte seed.
+func NewMasterKey(seed []byte, curve Curve) (*Key, error) {
+	if len(seed) < 16 || len(seed) > 64 {
+		return nil, ErrSeedLength
+	}
+
+	data := seed
+	for {
+		il, ir := hmacSHA512(curve.hmacKey(), data)
+		if curve == Ed25519 || validScalar(il) {
+			return &Key{curve: curve, key: il, chainCode: ir, private: true}, nil
+		}
+		// SLIP-10: retry with the whole HMAC output when IL is not a valid key
+		data = append(bytes.Clone(il), ir...)
+	}
+}
+
+// FromSeed derives the master key of a tree from a mnemonic seed.
+func FromSeed(seed *mnemonic.Seed, curve Curve) (*Key, error) {
+	return NewMasterKey(seed.Bytes, curve)
+}
+
+// Derive returns the child at index. Indexes at or above HardenedOffset are hardened.
+func (k *Key) Derive(index uint32) (*Key, error) {
+	if k.depth == 255 {
+		return nil, ErrMaxDepth
+	}
+
+	hardened := index >= HardenedOffset
+	switch {
+	case k.curve == Ed25519 && !k.private:
+		return nil, ErrPublicDerivation
+	case k.curve == Ed25519 && !hardened:
+		return nil, ErrNonHardened
+	case hardened && !k.private:
+		return nil, ErrHardenedPublic
+	}
+
+	var data []byte
+	if hardened {
+		data = append([]byte{0x00}, k.key...)
+	} else {
+		data = k.PublicKey()
+	}
+	data = binary.BigEndian.AppendUint32(data, index)
+
+	child := &Key{
+		curve:    k.curve,
+		depth:    k.depth + 1,
+		parentFP: k.Fingerprint(),
+		child:    index,
+		private:  k.private,
+	}
+
+	for {
+		il, ir := hmacSHA512(k.chainCode, data)
+		child.chainCode = ir
+
+		if k.curve == Ed25519 {
+			child.key = il
+			return child, nil
+		}
+
+		if key, ok := k.childKey(il); ok {
+			child.key = key
+			return child, nil
+		}
+		// SLIP-10: retry with 0x01 || IR || index when the child key is invalid
+		data = binary.BigEndian.AppendUint32(append([]byte{0x01}, ir...), index)
+	}
+}
+
+// childKey computes the secp256k1 child key for IL, returning false if it is invalid.
+func (k *Key) childKey(il []byte) ([]byte, bool) {
+	if !validScalar(il) {
+		return nil, false
+	}
+
+	if k.private {
+		return addScalars(il, k.key)
+	}
+	return addPoint(il, k.key)
+}
+
+// DerivePath derives the key at a path such as "m/44'/0'/0'/0/0", relative to k.
+func (k *Key) DerivePath(path string) (*Key, error) {
+	indexes, err := ParsePath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	key := k
+	for _, index := range indexes {
+		if key, err = key.Derive(index); err != nil {
+			return nil, err
+		}
+	}
+	return key, nil
+}
+
+// Neuter returns the public counterpart of k, which can only derive normal children.
+func (k *Key) Neuter() *Key {
+	if !k.private {
+		return k
+	}
+	pub := *k
+	pub.key = k.PublicKey()
+	pub.private = false
+	return &pub
+}
+
+// Curve returns the curve of the key tree.
+func (k *Key) Curve() Curve {
+	return k.curve
+}
+
+// IsPrivate reports whether k holds a private key.
+func (k *Key) IsPrivate() bool {
+	return k.private
+}
+
+// PrivateKey returns the 32-byte private key, or nil for a public key.
+// For ed25519 it is the RFC 8032 seed; see Ed25519PrivateKey.
+func (k *Key) PrivateKey() []byte {
+	if !k.private {
+		return nil
+	}
+	return bytes.Clone(k.key)
+}
+
+// PublicKey returns the 33-byte public key: a compressed point for
+// secp256k1, or 0x00 followed by the 32-byte key for ed25519.
+func (k *Key) PublicKey() []byte {
+	if !k.private {
+		return bytes.Clone(k.key)
+	}
+	if k.curve == Ed25519 {
+		pub := ed25519.NewKeyFromSeed(k.key).Public().(ed25519.PublicKey)
+		return append([]byte{0x00}, pub...)
+	}
+	return publicKey(k.key)
+}
+
+// Ed25519PrivateKey returns the key as a crypto/ed25519 private key, or nil if
+// k is not a private ed25519 key.
+func (k *Key) Ed25519PrivateKey() ed25519.PrivateKey {
+	if k.curve != Ed25519 || !k.private {
+		return nil
+	}
+	return ed25519.NewKeyFromSeed(k.key)
+}
+
+// ChainCode returns the 32-byte chain code.
+func (k *Key) ChainCode() []byte {
+	return bytes.Clone(k.chainCode)
+}
+
+// Depth returns the number of derivations from the master key.
+func (k *Key) Depth() uint8 {
+	return k.depth
+}
+
+// ChildNumber returns the index k was derived with; 0 for the master key.
+func (k *Key) ChildNumber() uint32 {
+	return k.child
+}
+
+// ParentFingerprint returns the fingerprint of the parent key; zero for the master key.
+func (k *Key) ParentFingerprint() [4]byte {
+	return k.parentFP
+}
+
+// Fingerprint returns the first four bytes of HASH160 of the public key.
+func (k *Key) Fingerprint() [4]byte {
+	var fp [4]byte
+	copy(fp[:], hash160(k.PublicKey()))
+	return fp
+}
+
+// String serializes k as a Base58Check xprv or xpub string.
+func (k *Key) String() string {
+	buf := make([]byte, 0, 82)
+	if k.private {
+		buf = append(buf, versionPrivate[:]...)
+	} else {
+		buf = append(buf, versionPublic[:]...)
+	}
+	buf = append(buf, k.depth)
+	buf = append(buf, k.parentFP[:]...)
+	buf = binary.BigEndian.AppendUint32(buf, k.child)
+	buf = append(buf, k.chainCode...)
+	if k.private {
+		buf = append(buf, 0x00)
+	}
+	buf = append(buf, k.key...)
+	buf = append(buf, checksum(buf)...)
+
+	out, _ := encoder.NewEncoding(encoder.Base58).Encode(buf)
+	return string(out)
+}
+
+// Parse reads a Base58Check xprv or xpub string. The serialization does not
+// record the curve, so it must be given.
+func Parse(s string, curve Curve) (*Key, error) {
+	buf, err := encoder.NewEncoding(encoder.Base58).Decode([]byte(s))
+	if err != nil || len(buf) != 82 {
+		return nil, ErrInvalidKey
+	}
+
+	payload, sum := buf[:78], buf[78:]
+	if !bytes.Equal(checksum(payload), sum) {
+		return nil, ErrChecksum
+	}
+
+	k := &Key{
+		curve:     curve,
+		depth:     payload[4],
+		child:     binary.BigEndian.Uint32(payload[9:13]),
+		chainCode: bytes.Clone(payload[13:45]),
+	}
+	copy(k.parentFP[:], payload[5:9])
+
+	if k.depth == 0 && (k.child != 0 || k.parentFP != [4]byte{}) {
+		return nil, ErrInvalidKey
+	}
+
+	keyData := payload[45:78]
+	switch [4]byte(payload[:4]) {
+	case versionPrivate:
+		if keyData[0] != 0x00 || (curve == Secp256k1 && !validScalar(keyData[1:])) {
+			return nil, ErrInvalidKey
+		}
+		k.key = bytes.Clone(keyData[1:])
+		k.private = true
+	case versionPublic:
+		if curve == Secp256k1 {
+			if !validPoint(keyData) {
+				return nil, ErrInvalidKey
+			}
+		} else if keyData[0] != 0x00 {
+			return nil, ErrInvalidKey
+		}
+		k.key = bytes.Clone(keyData)
+	default:
+		return nil, ErrInvalidKey
+	}
+	return k, nil
+}
+
+func hmacSHA512(key, data []byte) (il, ir []byte) {
+	mac := hmac.New(sha512.New, key)
+	mac.Write(data)
+	sum := mac.Sum(nil)
+	return sum[:32], sum[32:]
+}
+
+func hash160(b []byte) []byte {
+	sha := sha256.Sum256(b)
+	h := ripemd160.New()
+	h.Write(sha[:])
+	return h.Sum(nil)
+}
+
+// checksum returns the first four bytes of a double SHA-256.
+func checksum(b []byte) []byte {
+	first := sha256.Sum256(b)
+	second := sha256.Sum256(first[:])
+	return second[:4]
+}
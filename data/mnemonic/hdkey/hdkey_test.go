@@ -0,0 +1,185 @@
+package hdkey
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/inovacc/toolkit/data/mnemonic"
+)
+
+// BIP-32 test vector 1, which SLIP-10 reuses for secp256k1
+func TestSecp256k1Vector(t *testing.T) {
+	seed, _ := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	master, err := NewMasterKey(seed, Secp256k1)
+	if err != nil {
+		t.Fatalf("NewMasterKey() error: %v", err)
+	}
+
+	tests := []struct {
+		path string
+		xprv string
+		xpub string
+	}{
+		{
+			"m",
+			"xprv9s21ZrQH143K3QTDL4LXw2F7HEK3wJUD2nW2nRk4stbPy6cq3jPPqjiChkVvvNKmPGJxWUtg6LnF5kejMRNNU3TGtRBeJgk33yuGBxrMPHi",
+			"xpub661MyMwAqRbcFtXgS5sYJABqqG9YLmC4Q1Rdap9gSE8NqtwybGhePY2gZ29ESFjqJoCu1Rupje8YtGqsefD265TMg7usUDFdp6W1EGMcet8",
+		},
+		{
+			"m/0H",
+			"xprv9uHRZZhk6KAJC1avXpDAp4MDc3sQKNxDiPvvkX8Br5ngLNv1TxvUxt4cV1rGL5hj6KCesnDYUhd7oWgT11eZG7XnxHrnYeSvkzY7d2bhkJ7",
+			"xpub68Gmy5EdvgibQVfPdqkBBCHxA5htiqg55crXYuXoQRKfDBFA1WEjWgP6LHhwBZeNK1VTsfTFUHCdrfp1bgwQ9xv5ski8PX9rL2dZXvgGDnw",
+		},
+		{
+			"m/0H/1",
+			"xprv9wTYmMFdV23N2TdNG573QoEsfRrWKQgWeibmLntzniatZvR9BmLnvSxqu53Kw1UmYPxLgboyZQaXwTCg8MSY3H2EU4pWcQDnRnrVA1xe8fs",
+			"xpub6ASuArnXKPbfEwhqN6e3mwBcDTgzisQN1wXN9BJcM47sSikHjJf3UFHKkNAWbWMiGj7Wf5uMash7SyYq527Hqck2AxYysAA7xmALppuCkwQ",
+		},
+		{
+			"m/0H/1/2H",
+			"xprv9z4pot5VBttmtdRTWfWQmoH1taj2axGVzFqSb8C9xaxKymcFzXBDptWmT7FwuEzG3ryjH4ktypQSAewRiNMjANTtpgP4mLTj34bhnZX7UiM",
+			"xpub6D4BDPcP2GT577Vvch3R8wDkScZWzQzMMUm3PWbmWvVJrZwQY4VUNgqFJPMM3No2dFDFGTsxxpG5uJh7n7epu4trkrX7x7DogT5Uv6fcLW5",
+		},
+	}
+
+	for _, tt := range tests {
+		key, err := master.DerivePath(tt.path)
+		if err != nil {
+			t.Fatalf("DerivePath(%s) error: %v", tt.path, err)
+		}
+		if got := key.String(); got != tt.xprv {
+			t.Errorf("%s xprv = %s; want %s", tt.path, got, tt.xprv)
+		}
+		if got := key.Neuter().String(); got != tt.xpub {
+			t.Errorf("%s xpub = %s; want %s", tt.path, got, tt.xpub)
+		}
+
+		parsed, err := Parse(tt.xprv, Secp256k1)
+		if err != nil || parsed.String() != tt.xprv {
+			t.Errorf("Parse(%s) = %v, %v", tt.path, parsed, err)
+		}
+	}
+}
+
+func TestPublicDerivation(t *testing.T) {
+	seed, _ := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	master, _ := NewMasterKey(seed, Secp256k1)
+	account, _ := master.DerivePath("m/44'/0'/0'")
+
+	priv, _ := account.DerivePath("0/7")
+	pub, err := account.Neuter().DerivePath("0/7")
+	if err != nil {
+		t.Fatalf("public DerivePath() error: %v", err)
+	}
+	if pub.String() != priv.Neuter().String() {
+		t.Errorf("public derivation = %s; want %s", pub, priv.Neuter())
+	}
+
+	if _, err := account.Neuter().Derive(HardenedOffset); !errors.Is(err, ErrHardenedPublic) {
+		t.Errorf("expected ErrHardenedPublic, got %v", err)
+	}
+}
+
+// SLIP-10 test vector 1 for ed25519
+func TestEd25519Vector(t *testing.T) {
+	seed, _ := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	master, _ := NewMasterKey(seed, Ed25519)
+
+	tests := []struct {
+		path, chainCode, private, public string
+	}{
+		{
+			"m",
+			"90046a93de5380a72b5e45010748567d5ea02bbf6522f979e05c0d8d8ca9fffb",
+			"2b4be7f19ee27bbf30c667b642d5f4aa69fd169872f8fc3059c08ebae2eb19e7",
+			"00a4b2856bfec510abab89753fac1ac0e1112364e7d250545963f135f2a33188ed",
+		},
+		{
+			"m/0H",
+			"8b59aa11380b624e81507a27fedda59fea6d0b779a778918a2fd3590e16e9c69",
+			"68e0fe46dfb67e368c75379acec591dad19df3cde26e63b93a8e704f1dade7a3",
+			"008c8a13df77a28f3445213a0f432fde644acaa215fc72dcdf300d5efaa85d350c",
+		},
+	}
+
+	for _, tt := range tests {
+		key, err := master.DerivePath(tt.path)
+		if err != nil {
+			t.Fatalf("DerivePath(%s) error: %v", tt.path, err)
+		}
+		if got := hex.EncodeToString(key.ChainCode()); got != tt.chainCode {
+			t.Errorf("%s chain code = %s; want %s", tt.path, got, tt.chainCode)
+		}
+		if got := hex.EncodeToString(key.PrivateKey()); got != tt.private {
+			t.Errorf("%s private = %s; want %s", tt.path, got, tt.private)
+		}
+		if got := hex.EncodeToString(key.PublicKey()); got != tt.public {
+			t.Errorf("%s public = %s; want %s", tt.path, got, tt.public)
+		}
+	}
+
+	if _, err := master.Derive(0); !errors.Is(err, ErrNonHardened) {
+		t.Errorf("expected ErrNonHardened, got %v", err)
+	}
+}
+
+func TestFromSeed(t *testing.T) {
+	seed := mnemonic.NewSeed("legal winner thank year wave sausage worth useful legal winner thank yellow", "TREZOR")
+
+	a, err := FromSeed(seed, Secp256k1)
+	if err != nil {
+		t.Fatalf("FromSeed() error: %v", err)
+	}
+	b, _ := FromSeed(seed, Secp256k1)
+
+	x, _ := a.DerivePath("m/44'/0'/1'/0/0")
+	y, _ := b.DerivePath("m/44'/0'/1'/0/0")
+	if x.String() != y.String() || x.Depth() != 5 || x.ChildNumber() != 0 {
+		t.Errorf("derivation is not deterministic: %s, %s", x, y)
+	}
+}
+
+func TestParsePath(t *testing.T) {
+	got, err := ParsePath("m/44'/0h/1H/0/5")
+	if err != nil {
+		t.Fatalf("ParsePath() error: %v", err)
+	}
+	want := []uint32{44 + HardenedOffset, HardenedOffset, 1 + HardenedOffset, 0, 5}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("ParsePath() = %v; want %v", got, want)
+		}
+	}
+	if FormatPath(got) != "m/44'/0'/1'/0/5" {
+		t.Errorf("FormatPath() = %s", FormatPath(got))
+	}
+
+	for _, path := range []string{"m", "m/", ""} {
+		if got, err := ParsePath(path); err != nil || len(got) != 0 {
+			t.Errorf("ParsePath(%q) = %v, %v; want the root", path, got, err)
+		}
+	}
+
+	for _, path := range []string{"m/x", "m/1''", "m/2147483648", "m//1", "m44", "m44/0"} {
+		if _, err := ParsePath(path); err == nil {
+			t.Errorf("ParsePath(%q) should fail", path)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	xpub := "xpub661MyMwAqRbcFtXgS5sYJABqqG9YLmC4Q1Rdap9gSE8NqtwybGhePY2gZ29ESFjqJoCu1Rupje8YtGqsefD265TMg7usUDFdp6W1EGMcet8"
+	key, err := Parse(xpub, Secp256k1)
+	if err != nil || key.IsPrivate() {
+		t.Fatalf("Parse(xpub) = %v, %v", key, err)
+	}
+
+	broken := xpub[:len(xpub)-1] + "9"
+	if _, err := Parse(broken, Secp256k1); !errors.Is(err, ErrChecksum) {
+		t.Errorf("expected ErrChecksum, got %v", err)
+	}
+	if _, err := Parse("xpub", Secp256k1); !errors.Is(err, ErrInvalidKey) {
+		t.Errorf("expected ErrInvalidKey, got %v", err)
+	}
+}
@@ -0,0 +1,62 @@
+package hdkey
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParsePath parses a derivation path such as "m/44'/0'/0'/0/0" into child
+// indexes. Hardened indexes may be marked with ', h or H. The leading "m" is optional.
+func ParsePath(path string) ([]uint32, error) {
+	path = strings.TrimSpace(path)
+	if rest, ok := strings.CutPrefix(path, "m"); ok {
+		if rest != "" && rest[0] != '/' {
+			return nil, fmt.Errorf("hdkey: invalid path %q: \"m\" must be followed by \"/\"", path)
+		}
+		path = rest
+	}
+	path = strings.TrimPrefix(path, "/")
+	if path == "" {
+		return nil, nil
+	}
+
+	parts := strings.Split(path, "/")
+	indexes := make([]uint32, len(parts))
+	for i, part := range parts {
+		hardened := false
+		if trimmed := strings.TrimRight(part, "'hH"); trimmed != part {
+			if len(part)-len(trimmed) != 1 {
+				return nil, fmt.Errorf("hdkey: invalid path segment %q", part)
+			}
+			part, hardened = trimmed, true
+		}
+
+		n, err := strconv.ParseUint(part, 10, 32)
+		if err != nil || uint32(n) >= HardenedOffset {
+			return nil, fmt.Errorf("hdkey: invalid path segment %q", parts[i])
+		}
+
+		indexes[i] = uint32(n)
+		if hardened {
+			indexes[i] += HardenedOffset
+		}
+	}
+	return indexes, nil
+}
+
+// FormatPath renders child indexes as a path such as "m/44'/0'/0'/0/0".
+func FormatPath(indexes []uint32) string {
+	var b strings.Builder
+	b.WriteString("m")
+	for _, index := range indexes {
+		b.WriteByte('/')
+		if index >= HardenedOffset {
+			b.WriteString(strconv.FormatUint(uint64(index-HardenedOffset), 10))
+			b.WriteByte('\'')
+		} else {
+			b.WriteString(strconv.FormatUint(uint64(index), 10))
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,63 @@
+package hdkey
+
+import "github.com/decred/dcrd/dcrec/secp256k1/v4"
+
+// The curve arithmetic is delegated to the secp256k1 package of dcrd, also
+// used by btcd and lnd, which works on fixed-size field and scalar types with
+// constant time field operations instead of math/big.
+
+// validScalar reports whether b is a valid secp256k1 private key, 0 < b < n.
+func validScalar(b []byte) bool {
+	var s secp256k1.ModNScalar
+	overflow := s.SetByteSlice(b)
+	return len(b) == 32 && !overflow && !s.IsZero()
+}
+
+// addScalars returns (a + b) mod n, or false if the sum is zero.
+func addScalars(a, b []byte) ([]byte, bool) {
+	var sum, s secp256k1.ModNScalar
+	sum.SetByteSlice(a)
+	s.SetByteSlice(b)
+	if sum.Add(&s).IsZero() {
+		return nil, false
+	}
+	out := sum.Bytes()
+	return out[:], true
+}
+
+// publicKey returns the compressed public key of a private key.
+func publicKey(priv []byte) []byte {
+	return secp256k1.PrivKeyFromBytes(priv).PubKey().SerializeCompressed()
+}
+
+// validPoint reports whether b is a compressed point on the curve.
+func validPoint(b []byte) bool {
+	if len(b) != secp256k1.PubKeyBytesLenCompressed {
+		return false
+	}
+	_, err := secp256k1.ParsePubKey(b)
+	return err == nil
+}
+
+// addPoint returns the compressed point k·G + pub, or false if pub is not a
+// valid point or the sum is the point at infinity.
+func addPoint(k, pub []byte) ([]byte, bool) {
+	parent, err := secp256k1.ParsePubKey(pub)
+	if err != nil {
+		return nil, false
+	}
+
+	var s secp256k1.ModNScalar
+	s.SetByteSlice(k)
+
+	var p, kG, sum secp256k1.JacobianPoint
+	parent.AsJacobian(&p)
+	secp256k1.ScalarBaseMultNonConst(&s, &kG)
+	secp256k1.AddNonConst(&kG, &p, &sum)
+	if (sum.X.IsZero() && sum.Y.IsZero()) || sum.Z.IsZero() {
+		return nil, false
+	}
+
+	sum.ToAffine()
+	return secp256k1.NewPublicKey(&sum.X, &sum.Y).SerializeCompressed(), true
+}
@@ -1,6 +1,10 @@
 package mnemonic
 
 import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"slices"
 	"testing"
 
 	"github.com/inovacc/toolkit/data/mnemonic/entropy"
@@ -58,3 +62,124 @@ func TestGenerateSeed(t *testing.T) {
 		t.Error("Expected non-empty seed")
 	}
 }
+
+func TestParse(t *testing.T) {
+	// BIP-39 test vector for entropy 7f7f...7f
+	sentence := "legal winner thank year wave sausage worth useful legal winner thank yellow"
+
+	m, err := Parse(sentence, English)
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+	if len(m.Words) != 12 || m.Language != English {
+		t.Errorf("Parse() = %v", m)
+	}
+
+	ent, err := ToEntropy("  Legal winner\tthank year wave sausage worth useful legal winner thank yellow\n", English)
+	if err != nil {
+		t.Fatalf("ToEntropy() error: %v", err)
+	}
+	if hex.EncodeToString(ent) != "7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f" {
+		t.Errorf("ToEntropy() = %x", ent)
+	}
+}
+
+func TestParseRoundTrip(t *testing.T) {
+	for _, lang := range Languages() {
+		for _, bits := range []int{128, 160, 192, 224, 256} {
+			want, _ := entropy.Random(bits)
+			m, err := New(want, lang)
+			if err != nil {
+				t.Fatalf("New() error: %v", err)
+			}
+
+			got, err := ToEntropy(m.Sentence(), lang)
+			if err != nil {
+				t.Fatalf("ToEntropy(%s, %d bits) error: %v", lang, bits, err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("ToEntropy(%s) = %x; want %x", lang, got, want)
+			}
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		sentence string
+		want     error
+	}{
+		{"legal winner thank year wave sausage worth useful legal winner thank yellow", nil},
+		{"legal winner thank year wave sausage worth useful legal winner thank year", ErrChecksum},
+		{"legal winner thank year wave sausage worth useful legal winner thank", ErrWordCount},
+		{"legal winner thank year wave sausage worth useful legal winnerr thank yellow", ErrUnknownWord},
+	}
+
+	for _, tt := range tests {
+		if err := Validate(tt.sentence, English); !errors.Is(err, tt.want) {
+			t.Errorf("Validate(%q) = %v; want %v", tt.sentence, err, tt.want)
+		}
+	}
+
+	if !IsValid("legal winner thank year wave sausage worth useful legal winner thank yellow", English) {
+		t.Error("IsValid() = false")
+	}
+}
+
+func TestParseJapanese(t *testing.T) {
+	ent, _ := entropy.FromHex("00000000000000000000000000000000")
+	m, _ := New(ent, Japanese)
+
+	// Sentence joins Japanese words with the ideographic space
+	parsed, err := Parse(m.Sentence(), "")
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+	if parsed.Language != Japanese {
+		t.Errorf("Parse() detected %s", parsed.Language)
+	}
+}
+
+func TestDetectLanguage(t *testing.T) {
+	for _, lang := range Languages() {
+		ent, _ := entropy.Random(256)
+		m, _ := New(ent, lang)
+
+		got, err := DetectLanguage(m.Sentence())
+		if err != nil {
+			t.Fatalf("DetectLanguage(%s) error: %v", lang, err)
+		}
+		// Simplified and traditional Chinese share many characters
+		if got != lang && !(lang == ChineseT && got == ChineseS) {
+			t.Errorf("DetectLanguage() = %s; want %s", got, lang)
+		}
+	}
+
+	if _, err := DetectLanguage("not a mnemonic"); !errors.Is(err, ErrUnknownLanguage) {
+		t.Errorf("expected ErrUnknownLanguage, got %v", err)
+	}
+}
+
+func TestSuggest(t *testing.T) {
+	if got := Suggest("sausge", English); !slices.Contains(got, "sausage") {
+		t.Errorf("Suggest(sausge) = %v", got)
+	}
+	if got := Suggest("abstracted", English); len(got) != 1 || got[0] != "abstract" {
+		t.Errorf("Suggest(abstracted) = %v", got)
+	}
+	if got := Suggest("wnner", English); !slices.Contains(got, "winner") {
+		t.Errorf("Suggest(wnner) = %v", got)
+	}
+
+	var werr *WordError
+	err := Validate("legal winner thank year wave sausge worth useful legal winner thank yellow", English)
+	if !errors.As(err, &werr) || werr.Position != 5 || !slices.Contains(werr.Suggestions, "sausage") {
+		t.Errorf("Validate() = %v", err)
+	}
+
+	// Autodetection still reports the misspelled word
+	err = Validate("legal winner thank year wave sausge worth useful legal winner thank yellow", "")
+	if !errors.As(err, &werr) || werr.Word != "sausge" {
+		t.Errorf("Validate() with autodetect = %v", err)
+	}
+}
@@ -0,0 +1,288 @@
+package mnemonic
+
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/inovacc/toolkit/data/mnemonic/entropy"
+	"golang.org/x/text/unicode/norm"
+)
+
+var (
+	// ErrWordCount is returned when a sentence does not have 12, 15, 18, 21 or 24 words.
+	ErrWordCount = errors.New("mnemonic: word count must be 12, 15, 18, 21 or 24")
+
+	// ErrChecksum is returned when the checksum bits do not match the entropy.
+	ErrChecksum = errors.New("mnemonic: invalid checksum")
+
+	// ErrUnknownWord is wrapped by WordError.
+	ErrUnknownWord = errors.New("mnemonic: unknown word")
+
+	// ErrUnknownLanguage is returned when no word list contains every word of a sentence.
+	ErrUnknownLanguage = errors.New("mnemonic: unknown language")
+)
+
+// WordError reports a word that is not in the word list, along with likely corrections.
+type WordError struct {
+	Word        string
+	Position    int
+	Suggestions []string
+}
+
+func (e *WordError) Error() string {
+	if len(e.Suggestions) == 0 {
+		return fmt.Sprintf("mnemonic: unknown word %q at position %d", e.Word, e.Position+1)
+	}
+	return fmt.Sprintf("mnemonic: unknown word %q at position %d, did you mean %s?",
+		e.Word, e.Position+1, strings.Join(e.Suggestions, ", "))
+}
+
+func (e *WordError) Unwrap() error {
+	return ErrUnknownWord
+}
+
+// Languages returns the supported languages in the order DetectLanguage tries them.
+func Languages() []LanguageStr {
+	return []LanguageStr{English, Spanish, French, Italian, Portuguese, Czech, Japanese, Korean, ChineseS, ChineseT}
+}
+
+// indices maps every word of every list to its position.
+var indices = sync.OnceValue(func() map[LanguageStr]map[string]int {
+	m := make(map[LanguageStr]map[string]int, len(wordLists.words))
+	for lang, words := range wordLists.words {
+		idx := make(map[string]int, len(words))
+		for i, w := range words {
+			idx[w] = i
+		}
+		m[lang] = idx
+	}
+	return m
+})
+
+// splitWords splits a sentence on any Unicode whitespace, including the
+// ideographic space used by Japanese mnemonics, and normalizes the words.
+func splitWords(sentence string) []string {
+	words := strings.Fields(sentence)
+	for i, w := range words {
+		words[i] = normalizeWord(w)
+	}
+	return words
+}
+
+// normalizeWord lowercases w and converts it to NFKD, the form of the word
+// lists, so accented words typed in composed form still match.
+func normalizeWord(w string) string {
+	return norm.NFKD.String(strings.ToLower(w))
+}
+
+// Parse reads a mnemonic sentence, checking that every word is in the word list
+// and that the checksum matches. If lang is empty the language is detected.
+func Parse(sentence string, lang LanguageStr) (*Mnemonic, error) {
+	words := splitWords(sentence)
+	if lang == "" {
+		detected, err := DetectLanguage(sentence)
+		if err != nil {
+			// Report the misspelled words of the closest language instead
+			if detected = closestLanguage(words); detected == "" {
+				return nil, err
+			}
+		}
+		lang = detected
+	}
+
+	if _, err := toEntropy(words, lang); err != nil {
+		return nil, err
+	}
+	return &Mnemonic{Words: words, Language: lang}, nil
+}
+
+// Validate reports why sentence is not a valid mnemonic, or nil if it is.
+func Validate(sentence string, lang LanguageStr) error {
+	_, err := Parse(sentence, lang)
+	return err
+}
+
+// IsValid reports whether sentence is a valid mnemonic.
+func IsValid(sentence string, lang LanguageStr) bool {
+	return Validate(sentence, lang) == nil
+}
+
+// ToEntropy recovers the entropy encoded by a mnemonic sentence.
+func ToEntropy(sentence string, lang LanguageStr) ([]byte, error) {
+	m, err := Parse(sentence, lang)
+	if err != nil {
+		return nil, err
+	}
+	return m.Entropy()
+}
+
+// Entropy recovers the entropy encoded by the mnemonic words.
+func (m *Mnemonic) Entropy() ([]byte, error) {
+	return toEntropy(m.Words, m.Language)
+}
+
+func toEntropy(words []string, lang LanguageStr) ([]byte, error) {
+	index, ok := indices()[lang]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownLanguage, lang)
+	}
+
+	if len(words) < 12 || len(words) > 24 || len(words)%3 != 0 {
+		return nil, ErrWordCount
+	}
+
+	bits := make([]byte, 0, len(words)*11)
+	for i, w := range words {
+		n, ok := index[w]
+		if !ok {
+			return nil, &WordError{Word: w, Position: i, Suggestions: Suggest(w, lang)}
+		}
+		for b := 10; b >= 0; b-- {
+			bits = append(bits, '0'+byte(n>>b&1))
+		}
+	}
+
+	// The entropy is 32 bits for every checksum bit
+	entBits := len(bits) * 32 / 33
+	ent := make([]byte, entBits/8)
+	for i := range ent {
+		for _, bit := range bits[i*8 : i*8+8] {
+			ent[i] = ent[i]<<1 | (bit - '0')
+		}
+	}
+
+	if subtle.ConstantTimeCompare(entropy.CheckSum(ent), bits[entBits:]) != 1 {
+		return nil, ErrChecksum
+	}
+	return ent, nil
+}
+
+// DetectLanguage returns the language whose word list contains every word of
+// sentence. Some words appear in more than one list; when several languages
+// match, the one whose checksum validates wins, then the order of Languages.
+func DetectLanguage(sentence string) (LanguageStr, error) {
+	words := splitWords(sentence)
+	if len(words) == 0 {
+		return "", ErrUnknownLanguage
+	}
+
+	var candidates []LanguageStr
+	for _, lang := range Languages() {
+		index := indices()[lang]
+		if !slices.ContainsFunc(words, func(w string) bool { _, ok := index[w]; return !ok }) {
+			candidates = append(candidates, lang)
+		}
+	}
+
+	switch len(candidates) {
+	case 0:
+		return "", ErrUnknownLanguage
+	case 1:
+		return candidates[0], nil
+	}
+
+	for _, lang := range candidates {
+		if _, err := toEntropy(words, lang); err == nil {
+			return lang, nil
+		}
+	}
+	return candidates[0], nil
+}
+
+// closestLanguage returns the language that knows most of words, provided it
+// knows at least half of them, or "" otherwise.
+func closestLanguage(words []string) LanguageStr {
+	var best LanguageStr
+	bestCount := 0
+	for _, lang := range Languages() {
+		count := 0
+		for _, w := range words {
+			if _, ok := indices()[lang][w]; ok {
+				count++
+			}
+		}
+		if count > bestCount {
+			best, bestCount = lang, count
+		}
+	}
+	if bestCount*2 < len(words) {
+		return ""
+	}
+	return best
+}
+
+// maxSuggestions caps the corrections returned by Suggest.
+const maxSuggestions = 5
+
+// Suggest returns likely corrections for a misspelled word. BIP-39 lists are
+// built so the first four letters identify a word, so words sharing the
+// prefix come first, followed by words within two edits.
+func Suggest(word string, lang LanguageStr) []string {
+	words := wordLists.words[lang]
+	word = normalizeWord(word)
+	if _, ok := indices()[lang][word]; ok {
+		return []string{word}
+	}
+
+	var out []string
+	if p := prefix(word, 4); len([]rune(p)) == 4 {
+		for _, w := range words {
+			if prefix(w, 4) == p {
+				out = append(out, w)
+			}
+		}
+	}
+	if len(out) > 0 {
+		return out[:min(len(out), maxSuggestions)]
+	}
+
+	type scored struct {
+		word string
+		dist int
+	}
+	var near []scored
+	for _, w := range words {
+		if d := distance(word, w); d <= 2 {
+			near = append(near, scored{w, d})
+		}
+	}
+	slices.SortStableFunc(near, func(a, b scored) int { return a.dist - b.dist })
+
+	for _, s := range near[:min(len(near), maxSuggestions)] {
+		out = append(out, s.word)
+	}
+	return out
+}
+
+// prefix returns the first n runes of s.
+func prefix(s string, n int) string {
+	r := []rune(s)
+	return string(r[:min(len(r), n)])
+}
+
+// distance returns the Levenshtein distance between a and b, counted in runes.
+func distance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(rb)]
+}
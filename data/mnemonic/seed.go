@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/text/unicode/norm"
 )
 
 // Seed represents a binary seed used for HD wallet generation
@@ -13,8 +14,12 @@ type Seed struct {
 	Bytes []byte
 }
 
-// NewSeed create a new Seed with the given sentence and passphrase
+// NewSeed create a new Seed with the given sentence and passphrase.
+// Both are NFKD normalized as BIP-39 requires, so composed and decomposed
+// input, or a Japanese sentence joined by ideographic spaces, give the same seed.
 func NewSeed(sentence string, passphrase string) *Seed {
+	sentence = norm.NFKD.String(sentence)
+	passphrase = norm.NFKD.String(passphrase)
 	s := pbkdf2.Key([]byte(sentence), []byte(fmt.Sprintf("mnemonic%s", passphrase)), 2048, 64, sha512.New)
 	return &Seed{s}
 }
@@ -1,7 +1,10 @@
 package mnemonic
 
 import (
+	"encoding/hex"
 	"testing"
+
+	"golang.org/x/text/unicode/norm"
 )
 
 func TestSeed_ToHex(t *testing.T) {
@@ -18,3 +21,169 @@ func TestSeed_String(t *testing.T) {
 		t.Errorf("String() != ToHex()")
 	}
 }
+
+// seedVector is a BIP-39 vector: entropy, the mnemonic it encodes and the
+// seed for the passphrase. Mnemonics and passphrases are written in composed
+// (NFC) form, the way they are usually typed, to exercise normalization.
+type seedVector struct {
+	lang       LanguageStr
+	entropy    string
+	mnemonic   string
+	passphrase string
+	seed       string
+}
+
+// officialVectors come from the reference python-mnemonic (English) and
+// bip32JP (Japanese) test suites.
+var officialVectors = []seedVector{
+	{
+		English,
+		"00000000000000000000000000000000",
+		"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+		"TREZOR",
+		"c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04",
+	},
+	{
+		English,
+		"7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f",
+		"legal winner thank year wave sausage worth useful legal winner thank yellow",
+		"TREZOR",
+		"2e8905819b8723fe2c1d161860e5ee1830318dbf49a83bd451cfb8440c28bd6fa457fe1296106559a3c80937a1c1069be3a3a5bd381ee6260e8d9739fce1f607",
+	},
+	{
+		English,
+		"80808080808080808080808080808080",
+		"letter advice cage absurd amount doctor acoustic avoid letter advice cage above",
+		"TREZOR",
+		"d71de856f81a8acc65e6fc851a38d4d7ec216fd0796d0a6827a3ad6ed5511a30fa280f12eb2e47ed2ac03b5c462a0358d18d69fe4f985ec81778c1b370b652a8",
+	},
+	{
+		English,
+		"ffffffffffffffffffffffffffffffff",
+		"zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo wrong",
+		"TREZOR",
+		"ac27495480225222079d7be181583751e86f571027b0497b5b5d11218e0a8a13332572917f0f8e5a589620c6f15b11c61dee327651a14c34e18231052e48c069",
+	},
+	{
+		English,
+		"0000000000000000000000000000000000000000000000000000000000000000",
+		"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon art",
+		"TREZOR",
+		"bda85446c68413707090a52022edd26a1c9462295029f2e60cd7c4f2bbd3097170af7a4d73245cafa9c3cca8d561a7c3de6f5d4a10be8ed2a5e608d68f92fcc8",
+	},
+	{
+		English,
+		"9e885d952ad362caeb4efe34a8e91bd2",
+		"ozone drill grab fiber curtain grace pudding thank cruise elder eight picnic",
+		"TREZOR",
+		"274ddc525802f7c828d8ef7ddbcdc5304e87ac3535913611fbbfa986d0c9e5476c91689f9c8a54fd55bd38606aa6a8595ad213d4c9c9f9aca3fb217069a41028",
+	},
+	{
+		Japanese,
+		"00000000000000000000000000000000",
+		"あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あおぞら",
+		"㍍ガバヴァぱばぐゞちぢ十人十色",
+		"a262d6fb6122ecf45be09c50492b31f92e9beb7d9a845987a02cefda57a15f9c467a17872029a9e92299b5cbdf306e3a0ee620245cbd508959b6cb7ca637bd55",
+	},
+}
+
+// generatedVectors cover the languages without published vectors. They were
+// computed with an independent implementation (Python hashlib and unicodedata).
+var generatedVectors = []seedVector{
+	{
+		Spanish,
+		"7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f",
+		"ligero vista talar yogur venta queso yacer trozo ligero vista talar zafiro",
+		"contraseña",
+		"d8a171b114b76545fabdd50c17e8f50e01f5cd830dd76a5edd9a59e8c9c1e98906b7390c132359783a48aa2b6784bf680388f6f246d95b924f8856edf51c154d",
+	},
+	{
+		French,
+		"8080808080808080808080808080808080808080",
+		"indexer acompte bolide abrasif agréable dédale abusif appuyer indexer acompte bolide abrasif agréable dédale abroger",
+		"mot de passe éphémère",
+		"d858d75314e9925146890b3549799b764e97fab46744b899d497cddba6f2e68133169ff2d672a952e30b91929bd49ce85aee8d2986f4e8bc4363035d86c8c385",
+	},
+	{
+		Italian,
+		"9e885d952ad362caeb4efe34a8e91bd2",
+		"pesista educare imballo formica curvo imbevuto raddoppio sussurro croce eppure epilogo poligono",
+		"TREZOR",
+		"4ffd8b7879c0c6d7eee14682a26465d6429b8b921d6ea3299fb8a448d84d19b47ead5b23fd14449539cbd358abd19a23560dbd8c4bf6c153d98ea0fce7f474de",
+	},
+	{
+		Portuguese,
+		"ffffffffffffffffffffffffffffffffffffffffffffffff",
+		"zumbido zumbido zumbido zumbido zumbido zumbido zumbido zumbido zumbido zumbido zumbido zumbido zumbido zumbido zumbido zumbido zumbido viaduto",
+		"ação",
+		"3518c750a25b8b09655a7073590b0c18e46cc6d5ac3ae462bc74ff5e8059d24daba4d0cfeb35bac177b76556a11a8db19651042d8c4310083ba7e9ad67bbeedf",
+	},
+	{
+		Czech,
+		"7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f",
+		"obrazec znak uznat zubovina zeman skupina zrcadlo vzchopit obrazec znak uznat zubovina zeman skupina zrcadlo vzchopit obrazec znak uznat zubovina zaklepat",
+		"heslo příliš žluťoučké",
+		"6f0da14b275386a5a48ab614276683035a97c4838adedf485055a366b246adc482753f3902405defe3e095f42844d6c32a5a72ebc7cc267ce5e2cb31f7295e75",
+	},
+	{
+		Korean,
+		"80808080808080808080808080808080",
+		"실현 감소 기법 가상 걱정 무슨 가족 공간 실현 감소 기법 가득",
+		"비밀번호",
+		"f34875651f12eddaf8aa97b41e7e70bbc9d0e09f5cd5b1c5374846ca3f69072458f0f4609e0d2a83f61af21cbb535d0a168e269f82b047fa40effec47af7ff0f",
+	},
+	{
+		ChineseS,
+		"9e885d952ad362caeb4efe34a8e91bd2",
+		"蒙 台 脱 纪 构 硫 浆 霉 感 仅 鱼 汤",
+		"密码",
+		"5f26dcaaa6a0001b7fcb2bed315675b2d177f4101d44a742c9efce4df80910f4392bee60bf2b73f7ae566ca1ca6c1aa6082fc36332eac8d54d4c123728d8254d",
+	},
+	{
+		ChineseT,
+		"0000000000000000000000000000000000000000000000000000000000000000",
+		"的 的 的 的 的 的 的 的 的 的 的 的 的 的 的 的 的 的 的 的 的 的 的 性",
+		"密碼",
+		"b3adfcaa9d8de269dccb98e03b60520957315c5f1980d44dad2dbcb989d83a79d404ce30b6e0816f39631d5fafbc788855c56f6f8d77b822045bf538ae5575b7",
+	},
+}
+
+func TestSeedVectors(t *testing.T) {
+	for _, v := range append(officialVectors, generatedVectors...) {
+		ent, _ := hex.DecodeString(v.entropy)
+
+		m, err := New(ent, v.lang)
+		if err != nil {
+			t.Fatalf("New(%s) error: %v", v.lang, err)
+		}
+		if got, want := norm.NFKD.String(m.Sentence()), norm.NFKD.String(v.mnemonic); got != want {
+			t.Errorf("%s mnemonic = %q; want %q", v.lang, got, want)
+		}
+
+		if got := NewSeed(v.mnemonic, v.passphrase).ToHex(); got != v.seed {
+			t.Errorf("%s seed = %s; want %s", v.lang, got, v.seed)
+		}
+		if got := m.GenerateSeed(norm.NFKD.String(v.passphrase)).ToHex(); got != v.seed {
+			t.Errorf("%s GenerateSeed() = %s; want %s", v.lang, got, v.seed)
+		}
+
+		recovered, err := ToEntropy(v.mnemonic, v.lang)
+		if err != nil || hex.EncodeToString(recovered) != v.entropy {
+			t.Errorf("%s ToEntropy() = %x, %v; want %s", v.lang, recovered, err, v.entropy)
+		}
+	}
+}
+
+func TestSeedNormalization(t *testing.T) {
+	// "ñ" composed (U+00F1) and decomposed (n + U+0303) must give the same seed
+	composed := NewSeed("ábaco", "contraseña").ToHex()
+	decomposed := NewSeed("a\u0301baco", "contrasen\u0303a").ToHex()
+	if composed != decomposed {
+		t.Errorf("composed and decomposed input gave different seeds")
+	}
+
+	// NFKD turns the ideographic space into an ASCII space
+	if NewSeed("あいこくしん　あおぞら", "").ToHex() != NewSeed("あいこくしん あおぞら", "").ToHex() {
+		t.Errorf("ideographic space was not normalized")
+	}
+}
@@ -0,0 +1,63 @@
+package slip39
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+const (
+	// baseIterations is the PBKDF2 work for iteration exponent 0, spread over the rounds.
+	baseIterations = 10000
+	rounds         = 4
+)
+
+// encrypt protects the master secret with the passphrase using the SLIP-39
+// four-round Feistel network. The result has the length of the secret.
+func encrypt(secret []byte, passphrase string, exponent int, id uint16, extendable bool) []byte {
+	l, r := halves(secret)
+	salt := cipherSalt(id, extendable)
+	for i := 0; i < rounds; i++ {
+		l, r = r, xor(l, roundFunction(i, passphrase, exponent, salt, r))
+	}
+	return append(r, l...)
+}
+
+// decrypt reverses encrypt.
+func decrypt(ems []byte, passphrase string, exponent int, id uint16, extendable bool) []byte {
+	l, r := halves(ems)
+	salt := cipherSalt(id, extendable)
+	for i := rounds - 1; i >= 0; i-- {
+		l, r = r, xor(l, roundFunction(i, passphrase, exponent, salt, r))
+	}
+	return append(r, l...)
+}
+
+func halves(b []byte) ([]byte, []byte) {
+	half := len(b) / 2
+	return append([]byte(nil), b[:half]...), append([]byte(nil), b[half:]...)
+}
+
+// cipherSalt binds non-extendable backups to their identifier, so shares of
+// different backups cannot be mixed.
+func cipherSalt(id uint16, extendable bool) []byte {
+	if extendable {
+		return nil
+	}
+	return binary.BigEndian.AppendUint16([]byte("shamir"), id)
+}
+
+func roundFunction(i int, passphrase string, exponent int, salt, r []byte) []byte {
+	password := append([]byte{byte(i)}, passphrase...)
+	iterations := (baseIterations << exponent) / rounds
+	return pbkdf2.Key(password, append(append([]byte(nil), salt...), r...), iterations, len(r), sha256.New)
+}
+
+func xor(a, b []byte) []byte {
+	out := make([]byte, len(a))
+	for i := range a {
+		out[i] = a[i] ^ b[i]
+	}
+	return out
+}
@@ -0,0 +1,165 @@
+package slip39
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+const (
+	radixBits = 10
+	// headerWords hold the identifier, extendable flag, iteration exponent,
+	// group and member parameters: 40 bits.
+	headerWords   = 4
+	checksumWords = 3
+	minShareWords = headerWords + checksumWords + (minSecretBits+radixBits-1)/radixBits
+	minSecretBits = 128
+)
+
+// Share is one SLIP-39 mnemonic share, decoded.
+type Share struct {
+	Identifier        uint16 // 15 bits, common to all shares of a secret
+	Extendable        bool
+	IterationExponent int
+	GroupIndex        int
+	GroupThreshold    int
+	GroupCount        int
+	MemberIndex       int
+	MemberThreshold   int
+	Value             []byte
+}
+
+// customization returns the RS1024 customization string of the share.
+func (s *Share) customization() string {
+	if s.Extendable {
+		return "shamir_extendable"
+	}
+	return "shamir"
+}
+
+// Words encodes the share as its mnemonic words.
+func (s *Share) Words() []string {
+	ext := 0
+	if s.Extendable {
+		ext = 1
+	}
+
+	data := []int{
+		int(s.Identifier) >> 5,
+		(int(s.Identifier)&0x1f)<<5 | ext<<4 | s.IterationExponent,
+		s.GroupIndex<<6 | (s.GroupThreshold-1)<<2 | (s.GroupCount-1)>>2,
+		((s.GroupCount-1)&3)<<8 | s.MemberIndex<<4 | (s.MemberThreshold - 1),
+	}
+
+	// The value is left-padded with zero bits to a multiple of 10 bits
+	valueWords := (len(s.Value)*8 + radixBits - 1) / radixBits
+	v := new(big.Int).SetBytes(s.Value)
+	for i := valueWords - 1; i >= 0; i-- {
+		data = append(data, int(new(big.Int).Rsh(v, uint(i*radixBits)).Int64()&0x3ff))
+	}
+
+	data = append(data, rs1024Checksum(s.customization(), data)...)
+
+	words := make([]string, len(data))
+	for i, n := range data {
+		words[i] = wordlist[n]
+	}
+	return words
+}
+
+// Mnemonic encodes the share as a space-separated sentence.
+func (s *Share) Mnemonic() string {
+	return strings.Join(s.Words(), " ")
+}
+
+// ParseShare decodes and checks a mnemonic share.
+func ParseShare(mnemonic string) (*Share, error) {
+	words := strings.Fields(strings.ToLower(mnemonic))
+	if len(words) < minShareWords {
+		return nil, fmt.Errorf("%w: %d words, need at least %d", ErrInvalidShare, len(words), minShareWords)
+	}
+
+	data := make([]int, len(words))
+	for i, w := range words {
+		n, ok := wordIndex[w]
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown word %q at position %d", ErrInvalidShare, w, i+1)
+		}
+		data[i] = n
+	}
+
+	s := &Share{
+		Identifier:        uint16(data[0]<<5 | data[1]>>5),
+		Extendable:        data[1]>>4&1 == 1,
+		IterationExponent: data[1] & 0xf,
+		GroupIndex:        data[2] >> 6,
+		GroupThreshold:    data[2]>>2&0xf + 1,
+		GroupCount:        (data[2]&3)<<2 | data[3]>>8 + 1,
+		MemberIndex:       data[3] >> 4 & 0xf,
+		MemberThreshold:   data[3]&0xf + 1,
+	}
+
+	if !rs1024Verify(s.customization(), data) {
+		return nil, ErrChecksum
+	}
+
+	valueData := data[headerWords : len(data)-checksumWords]
+	padding := radixBits * len(valueData) % 16
+	if padding > 8 {
+		return nil, fmt.Errorf("%w: invalid length", ErrInvalidShare)
+	}
+
+	v := new(big.Int)
+	for _, n := range valueData {
+		v.Lsh(v, radixBits).Or(v, big.NewInt(int64(n)))
+	}
+	size := (radixBits*len(valueData) - padding) / 8
+	if v.BitLen() > size*8 {
+		return nil, fmt.Errorf("%w: padding bits are not zero", ErrInvalidShare)
+	}
+	s.Value = v.FillBytes(make([]byte, size))
+
+	if s.GroupCount < s.GroupThreshold {
+		return nil, fmt.Errorf("%w: group threshold %d exceeds group count %d", ErrInvalidShare, s.GroupThreshold, s.GroupCount)
+	}
+	return s, nil
+}
+
+// rs1024Polymod is the Reed-Solomon checksum over GF(1024) defined by SLIP-39.
+func rs1024Polymod(values []int) int {
+	gen := [10]int{
+		0xe0e040, 0x1c1c080, 0x3838100, 0x7070200, 0xe0e0009,
+		0x1c0c2412, 0x38086c24, 0x3090fc48, 0x21b1f890, 0x3f3f120,
+	}
+
+	chk := 1
+	for _, v := range values {
+		b := chk >> 20
+		chk = (chk&0xfffff)<<10 ^ v
+		for i := 0; i < 10; i++ {
+			if b>>i&1 == 1 {
+				chk ^= gen[i]
+			}
+		}
+	}
+	return chk
+}
+
+func customizationValues(customization string) []int {
+	values := make([]int, len(customization))
+	for i := range customization {
+		values[i] = int(customization[i])
+	}
+	return values
+}
+
+func rs1024Checksum(customization string, data []int) []int {
+	values := append(customizationValues(customization), data...)
+	values = append(values, 0, 0, 0)
+	chk := rs1024Polymod(values) ^ 1
+	return []int{chk >> 20 & 0x3ff, chk >> 10 & 0x3ff, chk & 0x3ff}
+}
+
+func rs1024Verify(customization string, data []int) bool {
+	return rs1024Polymod(append(customizationValues(customization), data...)) == 1
+}
@@ -0,0 +1,352 @@
+// Package slip39 implements SLIP-39 Shamir backups: a master secret, such as
+// the entropy of a BIP-39 mnemonic, is split into groups of mnemonic shares.
+// Recovery needs a threshold of groups, each with a threshold of its members.
+package slip39
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/inovacc/toolkit/data/algorithm/random"
+	"github.com/inovacc/toolkit/data/algorithm/shamir"
+	"github.com/inovacc/toolkit/data/mnemonic"
+)
+
+const (
+	maxShares = 16
+	// secretIndex and digestIndex are the x coordinates of the secret and its digest.
+	secretIndex = 255
+	digestIndex = 254
+	digestSize  = 4
+)
+
+var (
+	ErrInvalidShare     = errors.New("slip39: invalid share")
+	ErrChecksum         = errors.New("slip39: invalid share checksum")
+	ErrDigest           = errors.New("slip39: invalid digest, shares do not belong together")
+	ErrMismatchedShares = errors.New("slip39: shares belong to different backups")
+	ErrInsufficient     = errors.New("slip39: not enough shares")
+	ErrPassphrase       = errors.New("slip39: passphrase must be printable ASCII")
+)
+
+// Group describes one group of shares: Threshold of its Count members are
+// needed to recover the group.
+type Group struct {
+	Threshold int
+	Count     int
+}
+
+type OptsFn func(opts *Config)
+
+// Config holds the settings of a backup.
+type Config struct {
+	passphrase        string
+	iterationExponent int
+	extendable        bool
+	random            []random.Option
+}
+
+// NewConfig returns a Config with an empty passphrase and iteration exponent 1.
+func NewConfig(o ...OptsFn) *Config {
+	cfg := &Config{iterationExponent: 1}
+	for _, fn := range o {
+		fn(cfg)
+	}
+	return cfg
+}
+
+// WithPassphrase encrypts the master secret with passphrase. Recovering with a
+// different passphrase yields a different, valid-looking secret.
+func WithPassphrase(passphrase string) OptsFn {
+	return func(opts *Config) {
+		opts.passphrase = passphrase
+	}
+}
+
+// WithIterationExponent sets the PBKDF2 work factor to 10000·2^e iterations, 0 ≤ e ≤ 15.
+func WithIterationExponent(e int) OptsFn {
+	return func(opts *Config) {
+		opts.iterationExponent = e
+	}
+}
+
+// WithExtendable marks the backup as extendable: the identifier is left out
+// of the encryption, so new share sets can later be made for the same secret.
+func WithExtendable(extendable bool) OptsFn {
+	return func(opts *Config) {
+		opts.extendable = extendable
+	}
+}
+
+// WithRandom sets the random source options for the identifier and share
+// polynomials. It defaults to crypto/rand; only seed it in tests.
+func WithRandom(r ...random.Option) OptsFn {
+	return func(opts *Config) {
+		opts.random = r
+	}
+}
+
+// Split divides secret into mnemonic shares. The result holds the mnemonics of
+// each group, in order; groupThreshold groups are needed to recover.
+func Split(secret []byte, groupThreshold int, groups []Group, o ...OptsFn) ([][]string, error) {
+	shares, err := SplitShares(secret, groupThreshold, groups, o...)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([][]string, len(shares))
+	for i, group := range shares {
+		out[i] = make([]string, len(group))
+		for j, s := range group {
+			out[i][j] = s.Mnemonic()
+		}
+	}
+	return out, nil
+}
+
+// SplitShares is Split returning the decoded shares.
+func SplitShares(secret []byte, groupThreshold int, groups []Group, o ...OptsFn) ([][]Share, error) {
+	cfg := NewConfig(o...)
+
+	switch {
+	case len(secret)*8 < minSecretBits || len(secret)%2 != 0:
+		return nil, fmt.Errorf("slip39: secret must be at least %d bits and an even number of bytes", minSecretBits)
+	case cfg.iterationExponent < 0 || cfg.iterationExponent > 15:
+		return nil, fmt.Errorf("slip39: iteration exponent %d out of range 0-15", cfg.iterationExponent)
+	case len(groups) == 0 || len(groups) > maxShares:
+		return nil, fmt.Errorf("slip39: group count must be between 1 and %d", maxShares)
+	case groupThreshold < 1 || groupThreshold > len(groups):
+		return nil, fmt.Errorf("slip39: group threshold %d out of range 1-%d", groupThreshold, len(groups))
+	case !printable(cfg.passphrase):
+		return nil, ErrPassphrase
+	}
+	for i, g := range groups {
+		switch {
+		case g.Count < 1 || g.Count > maxShares || g.Threshold < 1 || g.Threshold > g.Count:
+			return nil, fmt.Errorf("slip39: group %d: threshold %d of %d is invalid", i+1, g.Threshold, g.Count)
+		case g.Threshold == 1 && g.Count > 1:
+			return nil, fmt.Errorf("slip39: group %d: use a single share instead of a 1-of-%d group", i+1, g.Count)
+		}
+	}
+
+	gen := random.New(random.NewOptions(cfg.random...).Source)
+	id := uint16(gen.IntN(1 << 15))
+	ems := encrypt(secret, cfg.passphrase, cfg.iterationExponent, id, cfg.extendable)
+
+	groupShares, err := splitSecret(gen, groupThreshold, len(groups), ems)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([][]Share, len(groups))
+	for i, g := range groups {
+		members, err := splitSecret(gen, g.Threshold, g.Count, groupShares[i].Value)
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range members {
+			out[i] = append(out[i], Share{
+				Identifier:        id,
+				Extendable:        cfg.extendable,
+				IterationExponent: cfg.iterationExponent,
+				GroupIndex:        i,
+				GroupThreshold:    groupThreshold,
+				GroupCount:        len(groups),
+				MemberIndex:       int(m.X),
+				MemberThreshold:   g.Threshold,
+				Value:             m.Value,
+			})
+		}
+	}
+	return out, nil
+}
+
+// SplitMnemonic backs up the entropy of a BIP-39 mnemonic as SLIP-39 shares.
+func SplitMnemonic(m *mnemonic.Mnemonic, groupThreshold int, groups []Group, o ...OptsFn) ([][]string, error) {
+	ent, err := m.Entropy()
+	if err != nil {
+		return nil, err
+	}
+	return Split(ent, groupThreshold, groups, o...)
+}
+
+// Combine recovers the master secret from mnemonic shares. Shares may come
+// in any order and from any groups; extra shares are ignored.
+func Combine(mnemonics []string, o ...OptsFn) ([]byte, error) {
+	shares := make([]*Share, len(mnemonics))
+	for i, m := range mnemonics {
+		s, err := ParseShare(m)
+		if err != nil {
+			return nil, fmt.Errorf("share %d: %w", i+1, err)
+		}
+		shares[i] = s
+	}
+	return CombineShares(shares, o...)
+}
+
+// CombineShares is Combine for decoded shares.
+func CombineShares(shares []*Share, o ...OptsFn) ([]byte, error) {
+	cfg := NewConfig(o...)
+	if len(shares) == 0 {
+		return nil, ErrInsufficient
+	}
+	if !printable(cfg.passphrase) {
+		return nil, ErrPassphrase
+	}
+
+	first := shares[0]
+	groups := make(map[int][]*Share)
+	for _, s := range shares {
+		if s.Identifier != first.Identifier || s.Extendable != first.Extendable ||
+			s.IterationExponent != first.IterationExponent || s.GroupThreshold != first.GroupThreshold ||
+			s.GroupCount != first.GroupCount || len(s.Value) != len(first.Value) {
+			return nil, ErrMismatchedShares
+		}
+		if members := groups[s.GroupIndex]; len(members) > 0 && members[0].MemberThreshold != s.MemberThreshold {
+			return nil, ErrMismatchedShares
+		}
+		groups[s.GroupIndex] = append(groups[s.GroupIndex], s)
+	}
+
+	indexes := make([]int, 0, len(groups))
+	for index := range groups {
+		indexes = append(indexes, index)
+	}
+	sort.Ints(indexes)
+
+	var groupShares []shamir.Share
+	for _, index := range indexes {
+		members := uniqueMembers(groups[index])
+		if len(members) < members[0].MemberThreshold {
+			continue
+		}
+
+		points := make([]shamir.Share, members[0].MemberThreshold)
+		for i, m := range members[:len(points)] {
+			points[i] = shamir.Share{X: byte(m.MemberIndex), Value: m.Value}
+		}
+		value, err := recoverSecret(len(points), points)
+		if err != nil {
+			return nil, fmt.Errorf("group %d: %w", index+1, err)
+		}
+		groupShares = append(groupShares, shamir.Share{X: byte(index), Value: value})
+	}
+
+	if len(groupShares) < first.GroupThreshold {
+		return nil, fmt.Errorf("%w: %d of %d groups complete", ErrInsufficient, len(groupShares), first.GroupThreshold)
+	}
+
+	ems, err := recoverSecret(first.GroupThreshold, groupShares[:first.GroupThreshold])
+	if err != nil {
+		return nil, err
+	}
+	return decrypt(ems, cfg.passphrase, first.IterationExponent, first.Identifier, first.Extendable), nil
+}
+
+// CombineMnemonic recovers a BIP-39 mnemonic backed up with SplitMnemonic.
+func CombineMnemonic(mnemonics []string, lang mnemonic.LanguageStr, o ...OptsFn) (*mnemonic.Mnemonic, error) {
+	ent, err := Combine(mnemonics, o...)
+	if err != nil {
+		return nil, err
+	}
+	return mnemonic.New(ent, lang)
+}
+
+// Validate checks the words and checksum of a single mnemonic share.
+func Validate(share string) error {
+	_, err := ParseShare(share)
+	return err
+}
+
+// uniqueMembers drops repeated member indexes, keeping the first share of each.
+func uniqueMembers(shares []*Share) []*Share {
+	seen := make(map[int]bool, len(shares))
+	var out []*Share
+	for _, s := range shares {
+		if !seen[s.MemberIndex] {
+			seen[s.MemberIndex] = true
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// splitSecret shares secret as SLIP-39 prescribes: threshold-2 random shares,
+// the digest at x=254 and the secret at x=255 fix the polynomial, and the
+// remaining shares are interpolated from them.
+func splitSecret(gen *random.Generator, threshold, count int, secret []byte) ([]shamir.Share, error) {
+	if threshold == 1 {
+		shares := make([]shamir.Share, count)
+		for i := range shares {
+			shares[i] = shamir.Share{X: byte(i), Value: append([]byte(nil), secret...)}
+		}
+		return shares, nil
+	}
+
+	shares := make([]shamir.Share, 0, count)
+	for i := 0; i < threshold-2; i++ {
+		value, err := gen.Bytes(uint32(len(secret)))
+		if err != nil {
+			return nil, err
+		}
+		shares = append(shares, shamir.Share{X: byte(i), Value: value})
+	}
+
+	r, err := gen.Bytes(uint32(len(secret) - digestSize))
+	if err != nil {
+		return nil, err
+	}
+	base := append(shares[:len(shares):len(shares)],
+		shamir.Share{X: digestIndex, Value: append(digest(r, secret), r...)},
+		shamir.Share{X: secretIndex, Value: secret},
+	)
+
+	for x := threshold - 2; x < count; x++ {
+		value, err := shamir.Interpolate(base, byte(x))
+		if err != nil {
+			return nil, err
+		}
+		shares = append(shares, shamir.Share{X: byte(x), Value: value})
+	}
+	return shares, nil
+}
+
+// recoverSecret interpolates the secret and checks it against the digest share.
+func recoverSecret(threshold int, shares []shamir.Share) ([]byte, error) {
+	if threshold == 1 {
+		return shares[0].Value, nil
+	}
+
+	secret, err := shamir.Interpolate(shares, secretIndex)
+	if err != nil {
+		return nil, err
+	}
+	d, err := shamir.Interpolate(shares, digestIndex)
+	if err != nil {
+		return nil, err
+	}
+	if subtle.ConstantTimeCompare(d[:digestSize], digest(d[digestSize:], secret)) != 1 {
+		return nil, ErrDigest
+	}
+	return secret, nil
+}
+
+func digest(r, secret []byte) []byte {
+	mac := hmac.New(sha256.New, r)
+	mac.Write(secret)
+	return mac.Sum(nil)[:digestSize]
+}
+
+// printable reports whether s only has printable ASCII, as SLIP-39 passphrases must.
+func printable(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < 32 || s[i] > 126 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,192 @@
+package slip39
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/inovacc/toolkit/data/algorithm/random"
+	"github.com/inovacc/toolkit/data/mnemonic"
+)
+
+// Vectors from the SLIP-39 reference implementation, passphrase "TREZOR"
+var vectors = []struct {
+	name      string
+	mnemonics []string
+	secret    string
+}{
+	{
+		"128 bits without sharing",
+		[]string{"duckling enlarge academic academic agency result length solution fridge kidney coal piece deal husband erode duke ajar critical decision keyboard"},
+		"bb54aac4b89dc868ba37d9cc21b2cece",
+	},
+	{
+		"128 bits, 2-of-3",
+		[]string{
+			"shadow pistol academic always adequate wildlife fancy gross oasis cylinder mustang wrist rescue view short owner flip making coding armed",
+			"shadow pistol academic acid actress prayer class unknown daughter sweater depict flip twice unkind craft early superior advocate guest smoking",
+		},
+		"b43ceb7e57a0ea8766221624d01b0864",
+	},
+	{
+		"256 bits without sharing",
+		[]string{"theory painting academic academic armed sweater year military elder discuss acne wildlife boring employer fused large satoshi bundle carbon diagnose anatomy hamster leaves tracks paces beyond phantom capital marvel lips brave detect luck"},
+		"989baf9dcaad5b10ca33dfd8cc75e42477025dce88ae83e75a230086a0e00e92",
+	},
+}
+
+func TestVectors(t *testing.T) {
+	for _, v := range vectors {
+		secret, err := Combine(v.mnemonics, WithPassphrase("TREZOR"))
+		if err != nil {
+			t.Fatalf("%s: Combine() error: %v", v.name, err)
+		}
+		if got := hex.EncodeToString(secret); got != v.secret {
+			t.Errorf("%s: secret = %s; want %s", v.name, got, v.secret)
+		}
+
+		// Decoding and re-encoding a share is lossless
+		for _, m := range v.mnemonics {
+			s, err := ParseShare(m)
+			if err != nil {
+				t.Fatalf("%s: ParseShare() error: %v", v.name, err)
+			}
+			if s.Mnemonic() != m {
+				t.Errorf("%s: Mnemonic() = %q; want %q", v.name, s.Mnemonic(), m)
+			}
+		}
+	}
+}
+
+func TestInvalidShares(t *testing.T) {
+	// Last word changed, from the reference vectors
+	bad := "duckling enlarge academic academic agency result length solution fridge kidney coal piece deal husband erode duke ajar critical decision kidney"
+	if err := Validate(bad); !errors.Is(err, ErrChecksum) {
+		t.Errorf("expected ErrChecksum, got %v", err)
+	}
+
+	if err := Validate("duckling enlarge academic"); !errors.Is(err, ErrInvalidShare) {
+		t.Errorf("expected ErrInvalidShare, got %v", err)
+	}
+
+	// One share of a 2-of-3 backup is not enough
+	_, err := Combine(vectors[1].mnemonics[:1])
+	if !errors.Is(err, ErrInsufficient) {
+		t.Errorf("expected ErrInsufficient, got %v", err)
+	}
+
+	// Shares of different backups
+	_, err = Combine([]string{vectors[0].mnemonics[0], vectors[1].mnemonics[0]})
+	if !errors.Is(err, ErrMismatchedShares) {
+		t.Errorf("expected ErrMismatchedShares, got %v", err)
+	}
+}
+
+func TestSplitCombine(t *testing.T) {
+	secret, _ := hex.DecodeString("0c94ad7bbd47ad0e3a8c5c1ce0b5a5e8a67cd3a9fa0bdd5bd1c6d04d0efd3ad3")
+	groups := []Group{{1, 1}, {2, 3}, {3, 5}}
+
+	for _, extendable := range []bool{false, true} {
+		shares, err := Split(secret, 2, groups,
+			WithPassphrase("correct horse"),
+			WithIterationExponent(0),
+			WithExtendable(extendable),
+		)
+		if err != nil {
+			t.Fatalf("Split() error: %v", err)
+		}
+		if len(shares) != 3 || len(shares[1]) != 3 || len(shares[2]) != 5 {
+			t.Fatalf("Split() returned groups of %d, %d, %d", len(shares[0]), len(shares[1]), len(shares[2]))
+		}
+
+		// Any two complete groups, in any order
+		sets := [][]string{
+			{shares[0][0], shares[1][2], shares[1][0]},
+			{shares[2][4], shares[2][1], shares[2][0], shares[1][1], shares[1][2]},
+			{shares[2][3], shares[0][0], shares[2][2], shares[2][1], shares[1][0]},
+		}
+		for i, set := range sets {
+			got, err := Combine(set, WithPassphrase("correct horse"))
+			if err != nil {
+				t.Fatalf("set %d: Combine() error: %v", i, err)
+			}
+			if !bytes.Equal(got, secret) {
+				t.Errorf("set %d: Combine() = %x", i, got)
+			}
+		}
+
+		// A wrong passphrase decrypts to a different secret
+		got, err := Combine(sets[0], WithPassphrase("wrong"))
+		if err != nil || bytes.Equal(got, secret) {
+			t.Errorf("wrong passphrase: Combine() = %x, %v", got, err)
+		}
+
+		// A group short of its threshold does not count
+		if _, err := Combine([]string{shares[0][0], shares[2][0], shares[2][1]}); !errors.Is(err, ErrInsufficient) {
+			t.Errorf("expected ErrInsufficient, got %v", err)
+		}
+	}
+}
+
+func TestSplitMnemonic(t *testing.T) {
+	m, _ := mnemonic.Parse("legal winner thank year wave sausage worth useful legal winner thank yellow", mnemonic.English)
+
+	shares, err := SplitMnemonic(m, 1, []Group{{2, 3}}, WithIterationExponent(0), WithRandom(random.WithSeed(1)))
+	if err != nil {
+		t.Fatalf("SplitMnemonic() error: %v", err)
+	}
+
+	recovered, err := CombineMnemonic(shares[0][1:], mnemonic.English)
+	if err != nil {
+		t.Fatalf("CombineMnemonic() error: %v", err)
+	}
+	if recovered.Sentence() != m.Sentence() {
+		t.Errorf("CombineMnemonic() = %q", recovered.Sentence())
+	}
+
+	again, _ := SplitMnemonic(m, 1, []Group{{2, 3}}, WithIterationExponent(0), WithRandom(random.WithSeed(1)))
+	if strings.Join(again[0], "|") != strings.Join(shares[0], "|") {
+		t.Error("same seed produced different shares")
+	}
+}
+
+func TestSplitErrors(t *testing.T) {
+	secret := make([]byte, 16)
+	tests := []struct {
+		name           string
+		secret         []byte
+		groupThreshold int
+		groups         []Group
+		opts           []OptsFn
+	}{
+		{"short secret", make([]byte, 14), 1, []Group{{1, 1}}, nil},
+		{"odd secret", make([]byte, 17), 1, []Group{{1, 1}}, nil},
+		{"group threshold", secret, 2, []Group{{1, 1}}, nil},
+		{"member threshold", secret, 1, []Group{{4, 3}}, nil},
+		{"1-of-n group", secret, 1, []Group{{1, 3}}, nil},
+		{"17 members", secret, 1, []Group{{2, 17}}, nil},
+		{"iteration exponent", secret, 1, []Group{{1, 1}}, []OptsFn{WithIterationExponent(16)}},
+		{"passphrase", secret, 1, []Group{{1, 1}}, []OptsFn{WithPassphrase("senha forte ç")}},
+	}
+
+	for _, tt := range tests {
+		if _, err := Split(tt.secret, tt.groupThreshold, tt.groups, tt.opts...); err == nil {
+			t.Errorf("%s: Split() should fail", tt.name)
+		}
+	}
+}
+
+func TestWordlist(t *testing.T) {
+	prefixes := make(map[string]bool)
+	for i, w := range wordlist {
+		if i > 0 && wordlist[i-1] >= w {
+			t.Fatalf("wordlist is not sorted at %q", w)
+		}
+		if prefixes[w[:4]] {
+			t.Fatalf("prefix of %q is not unique", w)
+		}
+		prefixes[w[:4]] = true
+	}
+}
@@ -1,6 +1,9 @@
 package mnemonic
 
-import "math/rand/v2"
+import (
+	"math/rand/v2"
+	"slices"
+)
 
 type LanguageStr string
 
@@ -58,6 +61,11 @@ func init() {
 	}
 }
 
+// WordList returns a copy of the 2048-word list of a language, or nil if the language is unknown.
+func WordList(lang LanguageStr) []string {
+	return slices.Clone(wordLists.words[lang])
+}
+
 func GetWord(lang LanguageStr, idx int) string {
 	return wordLists.words[lang][idx]
 }
@@ -21,3 +21,19 @@ func TestGenerateMnemonic(t *testing.T) {
 		t.Errorf("GenerateMnemonic() = %v; want a mnemonic", mnemonic)
 	}
 }
+
+func TestWordList(t *testing.T) {
+	words := WordList(English)
+	if len(words) != 2048 || words[0] != "abandon" {
+		t.Fatalf("WordList(English) has %d words, first %q", len(words), words[0])
+	}
+
+	words[0] = "changed"
+	if GetWord(English, 0) != "abandon" {
+		t.Error("WordList returned the shared slice")
+	}
+
+	if WordList("Klingon") != nil {
+		t.Error("expected nil for unknown language")
+	}
+}
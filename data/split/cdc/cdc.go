@@ -0,0 +1,228 @@
+// Package cdc implements content-defined chunking with FastCDC and a
+// content-addressed chunk store for deduplicated storage.
+//
+// Unlike split.SplitFile, which cuts a file into equal pieces, chunk boundaries
+// are chosen from the data itself: inserting or deleting bytes only changes the
+// chunks around the edit, so unchanged regions keep producing identical chunks.
+package cdc
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math/bits"
+
+	"github.com/inovacc/toolkit/data/algorithm/hashing"
+)
+
+// Default chunk sizes, tuned for large files such as VM images and datasets
+const (
+	DefaultMinSize = 16 * 1024
+	DefaultAvgSize = 64 * 1024
+	DefaultMaxSize = 256 * 1024
+)
+
+// Chunk is a content-defined slice of the input stream
+type Chunk struct {
+	Offset int64
+	Length int
+	Data   []byte
+	Digest hashing.Digest
+}
+
+// OptsFn configures a Chunker or Store
+type OptsFn func(opts *Config)
+
+// Config holds the chunk size bounds and the hash used to address chunks
+type Config struct {
+	minSize int
+	avgSize int
+	maxSize int
+	hasher  *hashing.Hasher
+}
+
+// NewConfig creates a Config using the default sizes and SHA-256
+func NewConfig(o ...OptsFn) *Config {
+	cfg := &Config{
+		minSize: DefaultMinSize,
+		avgSize: DefaultAvgSize,
+		maxSize: DefaultMaxSize,
+		hasher:  hashing.NewHasher(hashing.SHA256),
+	}
+	for _, opts := range o {
+		opts(cfg)
+	}
+	return cfg
+}
+
+// WithSizes sets the minimum, average and maximum chunk sizes in bytes.
+// The average must be a power of two between the minimum and the maximum.
+func WithSizes(minSize, avgSize, maxSize int) OptsFn {
+	return func(opts *Config) {
+		opts.minSize = minSize
+		opts.avgSize = avgSize
+		opts.maxSize = maxSize
+	}
+}
+
+// WithHasher sets the hasher used to compute chunk digests
+func WithHasher(h *hashing.Hasher) OptsFn {
+	return func(opts *Config) {
+		if h != nil {
+			opts.hasher = h
+		}
+	}
+}
+
+func (c *Config) validate() error {
+	switch {
+	case c.minSize < 64:
+		return fmt.Errorf("minimum chunk size %d is below 64 bytes", c.minSize)
+	case c.avgSize&(c.avgSize-1) != 0:
+		return fmt.Errorf("average chunk size %d is not a power of two", c.avgSize)
+	case c.minSize >= c.avgSize || c.avgSize >= c.maxSize:
+		return fmt.Errorf("chunk sizes must satisfy min < avg < max, got %d, %d, %d", c.minSize, c.avgSize, c.maxSize)
+	}
+	return nil
+}
+
+// Chunker splits a stream into content-defined chunks using FastCDC with
+// normalized chunking: a stricter mask is used before the average size and a
+// looser one after it, which narrows the chunk size distribution.
+type Chunker struct {
+	r      io.Reader
+	cfg    *Config
+	maskS  uint64
+	maskL  uint64
+	buf    []byte
+	start  int
+	end    int
+	offset int64
+	eof    bool
+}
+
+// NewChunker creates a Chunker reading from r
+func NewChunker(r io.Reader, o ...OptsFn) (*Chunker, error) {
+	cfg := NewConfig(o...)
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	return newChunker(r, cfg), nil
+}
+
+func newChunker(r io.Reader, cfg *Config) *Chunker {
+	// Normalization level 2: two bits harder before the average, two bits easier after
+	n := bits.TrailingZeros(uint(cfg.avgSize))
+	return &Chunker{
+		r:     r,
+		cfg:   cfg,
+		maskS: topBits(n + 2),
+		maskL: topBits(n - 2),
+		buf:   make([]byte, 2*cfg.maxSize),
+	}
+}
+
+// Next returns the next chunk, or io.EOF once the stream is exhausted.
+// Chunk.Data aliases an internal buffer and is only valid until the next call.
+func (c *Chunker) Next() (Chunk, error) {
+	if err := c.fill(); err != nil {
+		return Chunk{}, err
+	}
+	if c.start == c.end {
+		return Chunk{}, io.EOF
+	}
+
+	data := c.buf[c.start:c.end]
+	n := c.cut(data)
+	chunk := Chunk{
+		Offset: c.offset,
+		Length: n,
+		Data:   data[:n],
+		Digest: c.cfg.hasher.Sum(data[:n]),
+	}
+
+	c.start += n
+	c.offset += int64(n)
+	return chunk, nil
+}
+
+// fill makes sure at least maxSize bytes are buffered unless the stream has ended
+func (c *Chunker) fill() error {
+	if c.eof || c.end-c.start >= c.cfg.maxSize {
+		return nil
+	}
+
+	copy(c.buf, c.buf[c.start:c.end])
+	c.end -= c.start
+	c.start = 0
+
+	for c.end < len(c.buf) {
+		n, err := c.r.Read(c.buf[c.end:])
+		c.end += n
+		if errors.Is(err, io.EOF) {
+			c.eof = true
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if c.end-c.start >= c.cfg.maxSize {
+			return nil
+		}
+	}
+	return nil
+}
+
+// cut returns the length of the next chunk in data
+func (c *Chunker) cut(data []byte) int {
+	n := len(data)
+	if n <= c.cfg.minSize {
+		return n
+	}
+	if n > c.cfg.maxSize {
+		n = c.cfg.maxSize
+	}
+
+	center := c.cfg.avgSize
+	if n < center {
+		center = n
+	}
+
+	var fp uint64
+	i := c.cfg.minSize
+	for ; i < center; i++ {
+		fp = (fp << 1) + gear[data[i]]
+		if fp&c.maskS == 0 {
+			return i + 1
+		}
+	}
+	for ; i < n; i++ {
+		fp = (fp << 1) + gear[data[i]]
+		if fp&c.maskL == 0 {
+			return i + 1
+		}
+	}
+	return n
+}
+
+// topBits returns a mask with the n most significant bits set; the rolling
+// fingerprint shifts left, so the high bits depend on the most bytes
+func topBits(n int) uint64 {
+	return ^uint64(0) << (64 - n)
+}
+
+// gear maps each byte to a pseudo-random 64-bit value. The table is fixed so
+// that chunk boundaries are stable across runs and builds.
+var gear = func() [256]uint64 {
+	var table [256]uint64
+	state := uint64(0x9e3779b97f4a7c15)
+	for i := range table {
+		// splitmix64
+		state += 0x9e3779b97f4a7c15
+		z := state
+		z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+		z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+		table[i] = z ^ (z >> 31)
+	}
+	return table
+}()
@@ -0,0 +1,208 @@
+package cdc
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func randomData(t *testing.T, n int) []byte {
+	t.Helper()
+
+	data := make([]byte, n)
+	rand.New(rand.NewSource(42)).Read(data)
+	return data
+}
+
+func collect(t *testing.T, data []byte, o ...OptsFn) []Chunk {
+	t.Helper()
+
+	chunker, err := NewChunker(bytes.NewReader(data), o...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var chunks []Chunk
+	for {
+		chunk, err := chunker.Next()
+		if errors.Is(err, io.EOF) {
+			return chunks
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		chunk.Data = append([]byte(nil), chunk.Data...)
+		chunks = append(chunks, chunk)
+	}
+}
+
+func TestChunkerBounds(t *testing.T) {
+	const minSize, avgSize, maxSize = 2048, 8192, 32768
+	data := randomData(t, 1<<20)
+
+	chunks := collect(t, data, WithSizes(minSize, avgSize, maxSize))
+	if len(chunks) < 2 {
+		t.Fatalf("got %d chunks, want many", len(chunks))
+	}
+
+	var rebuilt []byte
+	for i, c := range chunks {
+		if c.Offset != int64(len(rebuilt)) {
+			t.Fatalf("chunk %d has offset %d, want %d", i, c.Offset, len(rebuilt))
+		}
+		if c.Length > maxSize || (c.Length < minSize && i != len(chunks)-1) {
+			t.Fatalf("chunk %d has length %d outside [%d, %d]", i, c.Length, minSize, maxSize)
+		}
+		rebuilt = append(rebuilt, c.Data...)
+	}
+
+	if !bytes.Equal(rebuilt, data) {
+		t.Fatal("chunks do not reassemble to the input")
+	}
+
+	avg := len(data) / len(chunks)
+	if avg < avgSize/2 || avg > avgSize*2 {
+		t.Errorf("average chunk length %d is far from %d", avg, avgSize)
+	}
+}
+
+func TestChunkerShiftResistance(t *testing.T) {
+	data := randomData(t, 1<<20)
+	edited := append(append(append([]byte(nil), data[:1000]...), []byte("inserted bytes")...), data[1000:]...)
+
+	digests := map[string]bool{}
+	original := collect(t, data, WithSizes(2048, 8192, 32768))
+	for _, c := range original {
+		digests[c.Digest.Hex()] = true
+	}
+
+	shared := 0
+	for _, c := range collect(t, edited, WithSizes(2048, 8192, 32768)) {
+		if digests[c.Digest.Hex()] {
+			shared++
+		}
+	}
+
+	if shared < len(original)-3 {
+		t.Errorf("only %d of %d chunks survived a small insertion", shared, len(original))
+	}
+}
+
+func TestChunkerInvalidSizes(t *testing.T) {
+	tests := []struct {
+		name               string
+		minSize, avg, maxS int
+	}{
+		{name: "tiny min", minSize: 8, avg: 1024, maxS: 4096},
+		{name: "avg not power of two", minSize: 512, avg: 1000, maxS: 4096},
+		{name: "min above avg", minSize: 4096, avg: 2048, maxS: 8192},
+		{name: "max below avg", minSize: 512, avg: 2048, maxS: 1024},
+	}
+
+	for _, tt := range tests {
+		if _, err := NewChunker(bytes.NewReader(nil), WithSizes(tt.minSize, tt.avg, tt.maxS)); err == nil {
+			t.Errorf("%s: expected error", tt.name)
+		}
+	}
+}
+
+func TestStore(t *testing.T) {
+	dir := t.TempDir()
+	store, err := NewStore(filepath.Join(dir, "chunks"), WithSizes(2048, 8192, 32768))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := randomData(t, 512*1024)
+	first, err := store.Put(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	edited := append(append([]byte(nil), data...), []byte("appended")...)
+	second, err := store.Put(bytes.NewReader(edited))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := store.Reassemble(second, &buf); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf.Bytes(), edited) {
+		t.Fatal("reassembled data differs from input")
+	}
+
+	out := filepath.Join(dir, "restored.bin")
+	if err := store.ReassembleFile(first, out); err != nil {
+		t.Fatal(err)
+	}
+	restored, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(restored, data) {
+		t.Fatal("reassembled file differs from input")
+	}
+
+	unique := map[string]bool{}
+	for _, ref := range append(first.Chunks, second.Chunks...) {
+		unique[ref.Digest] = true
+	}
+	if len(unique) >= len(first.Chunks)+len(second.Chunks)-1 {
+		t.Errorf("no chunks were deduplicated: %d unique of %d", len(unique), len(first.Chunks)+len(second.Chunks))
+	}
+
+	// Corrupt a chunk and make sure reassembly notices
+	if err := os.WriteFile(store.path(first.Chunks[0].Digest), []byte("corrupt"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Reassemble(first, io.Discard); err == nil {
+		t.Error("expected error for corrupted chunk")
+	}
+}
+
+func TestStoreInvalidDigest(t *testing.T) {
+	dir := t.TempDir()
+	store, err := NewStore(filepath.Join(dir, "chunks"), WithSizes(2048, 8192, 32768))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := randomData(t, 4096)
+	recipe, err := store.Put(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	digest := recipe.Chunks[0].Digest
+
+	outside := filepath.Join(dir, "secret")
+	if err := os.WriteFile(outside, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, bad := range []string{
+		"",
+		"../secret",
+		"../../../secret",
+		outside,
+		strings.ToUpper(digest),
+		digest[:len(digest)-2],
+		digest + "00",
+	} {
+		if store.Has(bad) {
+			t.Errorf("Has(%q) = true", bad)
+		}
+		if _, err := store.Get(bad); err == nil {
+			t.Errorf("Get(%q) succeeded", bad)
+		}
+	}
+	if !store.Has(digest) {
+		t.Errorf("Has(%q) = false", digest)
+	}
+}
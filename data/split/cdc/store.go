@@ -0,0 +1,173 @@
+package cdc
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// Ref identifies a stored chunk by digest and length
+type Ref struct {
+	Digest string `json:"digest"`
+	Length int    `json:"length"`
+}
+
+// Recipe lists the chunks needed to reassemble a stream
+type Recipe struct {
+	Size   int64 `json:"size"`
+	Chunks []Ref `json:"chunks"`
+}
+
+// Store is a content-addressed chunk store on disk. Each chunk is saved once
+// under its hexadecimal digest, so identical chunks shared by many files or
+// versions only take space once.
+type Store struct {
+	dir string
+	cfg *Config
+	// digestLen is the length of a hexadecimal digest of the configured hasher
+	digestLen int
+}
+
+// NewStore opens or creates a chunk store rooted at dir.
+// The options must match between runs so digests and boundaries stay comparable.
+func NewStore(dir string, o ...OptsFn) (*Store, error) {
+	cfg := NewConfig(o...)
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &Store{dir: dir, cfg: cfg, digestLen: 2 * cfg.hasher.GetSize()}, nil
+}
+
+// Put chunks r, saves the chunks the store does not have yet and returns the recipe to rebuild r
+func (s *Store) Put(r io.Reader) (*Recipe, error) {
+	chunker := newChunker(r, s.cfg)
+	recipe := &Recipe{}
+	for {
+		chunk, err := chunker.Next()
+		if errors.Is(err, io.EOF) {
+			return recipe, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		digest := chunk.Digest.Hex()
+		if !s.Has(digest) {
+			if err := s.write(digest, chunk.Data); err != nil {
+				return nil, err
+			}
+		}
+
+		recipe.Chunks = append(recipe.Chunks, Ref{Digest: digest, Length: chunk.Length})
+		recipe.Size += int64(chunk.Length)
+	}
+}
+
+// Has reports whether the chunk with the given hexadecimal digest is stored
+func (s *Store) Has(digest string) bool {
+	if !s.validDigest(digest) {
+		return false
+	}
+	_, err := os.Stat(s.path(digest))
+	return err == nil
+}
+
+// Get returns the chunk with the given hexadecimal digest after checking its integrity
+func (s *Store) Get(digest string) ([]byte, error) {
+	if !s.validDigest(digest) {
+		return nil, fmt.Errorf("invalid chunk digest %q", digest)
+	}
+	data, err := os.ReadFile(s.path(digest))
+	if err != nil {
+		return nil, err
+	}
+	if !s.cfg.hasher.VerifyHex(data, digest) {
+		return nil, fmt.Errorf("chunk %s is corrupted", digest)
+	}
+	return data, nil
+}
+
+// Reassemble writes the chunks listed in recipe to w in order
+func (s *Store) Reassemble(recipe *Recipe, w io.Writer) error {
+	var written int64
+	for _, ref := range recipe.Chunks {
+		data, err := s.Get(ref.Digest)
+		if err != nil {
+			return err
+		}
+		if len(data) != ref.Length {
+			return fmt.Errorf("chunk %s has length %d, expected %d", ref.Digest, len(data), ref.Length)
+		}
+		if _, err := w.Write(data); err != nil {
+			return err
+		}
+		written += int64(len(data))
+	}
+
+	if written != recipe.Size {
+		return fmt.Errorf("reassembled %d bytes, expected %d", written, recipe.Size)
+	}
+	return nil
+}
+
+// ReassembleFile rebuilds the stream described by recipe into the file at path
+func (s *Store) ReassembleFile(recipe *Recipe, path string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if err := s.Reassemble(recipe, file); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// write saves a chunk atomically so an interrupted Put never leaves a partial chunk behind
+func (s *Store) write(digest string, data []byte) error {
+	path := s.path(digest)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".chunk-*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// validDigest reports whether digest is a lowercase hexadecimal digest of the
+// store's hasher, so a digest read from a recipe can never name a path outside the store
+func (s *Store) validDigest(digest string) bool {
+	if len(digest) != s.digestLen {
+		return false
+	}
+	for i := 0; i < len(digest); i++ {
+		if c := digest[i]; (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+// path fans chunks out over two directory levels to keep directories small.
+// The digest must have been checked with validDigest.
+func (s *Store) path(digest string) string {
+	return filepath.Join(s.dir, digest[:2], digest[2:4], digest)
+}
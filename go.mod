@@ -5,6 +5,7 @@ go 1.23.0
 require (
 	gitee.com/dromara/carbon/v2 v2.6.4
 	github.com/brianvoe/gofakeit/v7 v7.2.1
+	github.com/decred/dcrd/dcrec/secp256k1/v4 v4.4.0
 	github.com/disintegration/imaging v1.6.2
 	github.com/divan/txqr v0.0.0-20190110104519-d92929c20d82
 	github.com/fogleman/gg v1.3.0
@@ -14,11 +15,14 @@ require (
 	github.com/stretchr/testify v1.10.0
 	golang.org/x/crypto v0.38.0
 	golang.org/x/net v0.40.0
+	golang.org/x/term v0.32.0
+	golang.org/x/text v0.25.0
 	golang.org/x/tools v0.33.0
 )
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/decred/dcrd/crypto/blake256 v1.1.0 // indirect
 	github.com/golang/freetype v0.0.0-20170609003504-e2365dfdc4a0 // indirect
 	github.com/google/gofountain v0.0.0-20160820054803-4928733085e9 // indirect
 	github.com/makiuchi-d/gozxing v0.1.1 // indirect
@@ -27,7 +31,6 @@ require (
 	golang.org/x/mod v0.24.0 // indirect
 	golang.org/x/sync v0.14.0 // indirect
 	golang.org/x/sys v0.33.0 // indirect
-	golang.org/x/text v0.25.0 // indirect
 	golang.org/x/xerrors v0.0.0-20240903120638-7835f813f4da // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )